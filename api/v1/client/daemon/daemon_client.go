@@ -86,6 +86,37 @@ func (a *Client) GetHealthz(params *GetHealthzParams) (*GetHealthzOK, error) {
 
 }
 
+/*
+GetNode gets list of all nodes
+
+Returns an array of all nodes registered in the key-value store.
+
+*/
+func (a *Client) GetNode(params *GetNodeParams) (*GetNodeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetNodeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetNode",
+		Method:             "GET",
+		PathPattern:        "/node",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetNodeReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetNodeOK), nil
+
+}
+
 /*
 PatchConfig modifies daemon configuration
 
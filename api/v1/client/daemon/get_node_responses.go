@@ -0,0 +1,63 @@
+package daemon
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+// GetNodeReader is a Reader for the GetNode structure.
+type GetNodeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetNodeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetNodeOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetNodeOK creates a GetNodeOK with default headers values
+func NewGetNodeOK() *GetNodeOK {
+	return &GetNodeOK{}
+}
+
+/*GetNodeOK handles this case with default header values.
+
+Success
+*/
+type GetNodeOK struct {
+	Payload []*models.Node
+}
+
+func (o *GetNodeOK) Error() string {
+	return fmt.Sprintf("[GET /node][%d] getNodeOK  %+v", 200, o.Payload)
+}
+
+func (o *GetNodeOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+)
+
+// DeprecatedOption Deprecated configuration option which is still accepted
+// swagger:model DeprecatedOption
+type DeprecatedOption struct {
+
+	// Deprecated name of the option
+	Name string `json:"name,omitempty"`
+
+	// Release in which the deprecated option will be removed
+	RemovedIn string `json:"removed-in,omitempty"`
+
+	// Name of the option replacing the deprecated option
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// Validate validates this deprecated option
+func (m *DeprecatedOption) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
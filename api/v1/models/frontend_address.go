@@ -46,7 +46,7 @@ var frontendAddressTypeProtocolPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["tcp","udp","any"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["tcp","udp","sctp","any"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -59,6 +59,8 @@ const (
 	FrontendAddressProtocolTCP string = "tcp"
 	// FrontendAddressProtocolUDP captures enum value "udp"
 	FrontendAddressProtocolUDP string = "udp"
+	// FrontendAddressProtocolSCTP captures enum value "sctp"
+	FrontendAddressProtocolSCTP string = "sctp"
 	// FrontendAddressProtocolAny captures enum value "any"
 	FrontendAddressProtocolAny string = "any"
 )
@@ -0,0 +1,33 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+)
+
+// Node Node running a Cilium agent
+// swagger:model Node
+type Node struct {
+
+	// Address (tcp://host:port) of the read-only API of the agent, empty
+	// if the agent does not serve the read-only API over TCP
+	//
+	APIAddress string `json:"api-address,omitempty"`
+
+	// Name of the node
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this node
+func (m *Node) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -43,7 +43,7 @@ var portTypeProtocolPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["tcp","udp","any"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["tcp","udp","sctp","any"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -56,6 +56,8 @@ const (
 	PortProtocolTCP string = "tcp"
 	// PortProtocolUDP captures enum value "udp"
 	PortProtocolUDP string = "udp"
+	// PortProtocolSCTP captures enum value "sctp"
+	PortProtocolSCTP string = "sctp"
 	// PortProtocolAny captures enum value "any"
 	PortProtocolAny string = "any"
 )
@@ -4,6 +4,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -20,6 +22,9 @@ type StatusResponse struct {
 	// Status of local container runtime
 	ContainerRuntime *Status `json:"container-runtime,omitempty"`
 
+	// Deprecated configuration options in use
+	DeprecatedOptions []*DeprecatedOption `json:"deprecated-options"`
+
 	// Status of IP address management
 	IPAM *IPAMStatus `json:"ipam,omitempty"`
 
@@ -44,6 +49,11 @@ func (m *StatusResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDeprecatedOptions(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateIPAM(formats); err != nil {
 		// prop
 		res = append(res, err)
@@ -103,6 +113,33 @@ func (m *StatusResponse) validateContainerRuntime(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *StatusResponse) validateDeprecatedOptions(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DeprecatedOptions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DeprecatedOptions); i++ {
+
+		if swag.IsZero(m.DeprecatedOptions[i]) { // not required
+			continue
+		}
+
+		if m.DeprecatedOptions[i] != nil {
+
+			if err := m.DeprecatedOptions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("deprecated-options" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *StatusResponse) validateIPAM(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.IPAM) { // not required
@@ -567,6 +567,26 @@ func init() {
         }
       }
     },
+    "/node": {
+      "get": {
+        "description": "Returns an array of all nodes registered in the key-value store.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Get list of all nodes",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Node"
+              }
+            }
+          }
+        }
+      }
+    },
     "/policy": {
       "get": {
         "description": "Returns the entire policy tree with all children.\n",
@@ -869,6 +889,24 @@ func init() {
         }
       }
     },
+    "DeprecatedOption": {
+      "description": "Deprecated configuration option which is still accepted",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Deprecated name of the option",
+          "type": "string"
+        },
+        "removed-in": {
+          "description": "Release in which the deprecated option will be removed",
+          "type": "string"
+        },
+        "replacement": {
+          "description": "Name of the option replacing the deprecated option",
+          "type": "string"
+        }
+      }
+    },
     "Endpoint": {
       "description": "Endpoint",
       "type": "object",
@@ -1074,6 +1112,7 @@ func init() {
           "enum": [
             "tcp",
             "udp",
+            "sctp",
             "any"
           ]
         }
@@ -1204,6 +1243,20 @@ func init() {
         "type": "string"
       }
     },
+    "Node": {
+      "description": "Node running a Cilium agent",
+      "type": "object",
+      "properties": {
+        "api-address": {
+          "description": "Address (tcp://host:port) of the read-only API of the agent, empty\nif the agent does not serve the read-only API over TCP\n",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the node",
+          "type": "string"
+        }
+      }
+    },
     "NodeAddressing": {
       "description": "Addressing information of a node for all address families",
       "type": "object",
@@ -1265,6 +1318,7 @@ func init() {
           "enum": [
             "tcp",
             "udp",
+            "sctp",
             "any"
           ]
         }
@@ -1340,6 +1394,13 @@ func init() {
           "description": "Status of local container runtime",
           "$ref": "#/definitions/Status"
         },
+        "deprecated-options": {
+          "description": "Deprecated configuration options in use",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DeprecatedOption"
+          }
+        },
         "ipam": {
           "description": "Status of IP address management",
           "$ref": "#/definitions/IPAMStatus"
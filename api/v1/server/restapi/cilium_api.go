@@ -71,6 +71,9 @@ func NewCiliumAPI(spec *loads.Document) *CiliumAPI {
 		PolicyGetIdentityIDHandler: policy.GetIdentityIDHandlerFunc(func(params policy.GetIdentityIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation PolicyGetIdentityID has not yet been implemented")
 		}),
+		DaemonGetNodeHandler: daemon.GetNodeHandlerFunc(func(params daemon.GetNodeParams) middleware.Responder {
+			return middleware.NotImplemented("operation DaemonGetNode has not yet been implemented")
+		}),
 		PolicyGetPolicyHandler: policy.GetPolicyHandlerFunc(func(params policy.GetPolicyParams) middleware.Responder {
 			return middleware.NotImplemented("operation PolicyGetPolicy has not yet been implemented")
 		}),
@@ -152,6 +155,8 @@ type CiliumAPI struct {
 	PolicyGetIdentityHandler policy.GetIdentityHandler
 	// PolicyGetIdentityIDHandler sets the operation handler for the get identity ID operation
 	PolicyGetIdentityIDHandler policy.GetIdentityIDHandler
+	// DaemonGetNodeHandler sets the operation handler for the get node operation
+	DaemonGetNodeHandler daemon.GetNodeHandler
 	// PolicyGetPolicyHandler sets the operation handler for the get policy operation
 	PolicyGetPolicyHandler policy.GetPolicyHandler
 	// PolicyGetPolicyResolveHandler sets the operation handler for the get policy resolve operation
@@ -289,6 +294,10 @@ func (o *CiliumAPI) Validate() error {
 		unregistered = append(unregistered, "policy.GetIdentityIDHandler")
 	}
 
+	if o.DaemonGetNodeHandler == nil {
+		unregistered = append(unregistered, "daemon.GetNodeHandler")
+	}
+
 	if o.PolicyGetPolicyHandler == nil {
 		unregistered = append(unregistered, "policy.GetPolicyHandler")
 	}
@@ -481,6 +490,11 @@ func (o *CiliumAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/identity/{id}"] = policy.NewGetIdentityID(o.context, o.PolicyGetIdentityIDHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/node"] = daemon.NewGetNode(o.context, o.DaemonGetNodeHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
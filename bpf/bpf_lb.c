@@ -81,6 +81,7 @@ static inline int handle_ipv6(struct __sk_buff *skb)
 	csum_l4_offset_and_flags(nexthdr, &csum_off);
 
 #ifdef LB_L4
+	key.proto = nexthdr;
 	ret = extract_l4_port(skb, nexthdr, l4_off, &key.dport);
 	if (IS_ERR(ret)) {
 		if (ret == DROP_UNKNOWN_L4) {
@@ -98,7 +99,7 @@ static inline int handle_ipv6(struct __sk_buff *skb)
 	}
 
 	slave = lb6_select_slave(skb, &key, svc->count, svc->weight);
-	if (!(svc = lb6_lookup_slave(skb, &key, slave)))
+	if (!(svc = lb6_lookup_active_slave(skb, &key, slave, nexthdr, l4_off)))
 		return DROP_NO_SERVICE;
 
 	ipv6_addr_copy(&new_dst, &svc->target);
@@ -139,6 +140,7 @@ static inline int handle_ipv4(struct __sk_buff *skb)
 	csum_l4_offset_and_flags(nexthdr, &csum_off);
 
 #ifdef LB_L4
+	key.proto = nexthdr;
 	ret = extract_l4_port(skb, nexthdr, l4_off, &key.dport);
 	if (IS_ERR(ret)) {
 		if (ret == DROP_UNKNOWN_L4) {
@@ -156,7 +158,7 @@ static inline int handle_ipv4(struct __sk_buff *skb)
 	}
 
 	slave = lb4_select_slave(skb, &key, svc->count, svc->weight);
-	if (!(svc = lb4_lookup_slave(skb, &key, slave)))
+	if (!(svc = lb4_lookup_active_slave(skb, &key, slave, nexthdr, l4_off)))
 		return DROP_NO_SERVICE;
 
 	new_dst = svc->target;
@@ -45,6 +45,8 @@
 #include "lib/dbg.h"
 #include "lib/csum.h"
 #include "lib/conntrack.h"
+#include "lib/mirror.h"
+#include "lib/flow.h"
 
 #define POLICY_ID ((LXC_ID << 16) | SECLABEL)
 
@@ -649,11 +651,13 @@ int handle_ingress(struct __sk_buff *skb)
 #endif
 	switch (skb->protocol) {
 	case bpf_htons(ETH_P_IPV6):
+		mirror_packet(skb);
 		/* This is considered the fast path, no tail call */
 		ret = handle_ipv6(skb);
 		break;
 
 	case bpf_htons(ETH_P_IP):
+		mirror_packet(skb);
 		ep_tail_call(skb, CILIUM_CALL_IPV4);
 		ret = DROP_MISSED_TAIL_CALL;
 		break;
@@ -751,6 +755,9 @@ static inline int __inline__ ipv6_policy(struct __sk_buff *skb, int ifindex, __u
 	 * lookup if policy accounting is disabled */
 	verdict = policy_can_access(&POLICY_MAP, skb, src_label);
 	if (unlikely(ret == CT_NEW)) {
+		/* The tuple is invalidated by ct_create6() */
+		__u8 nexthdr = tuple.nexthdr;
+
 		if (verdict != TC_ACT_OK)
 			return DROP_POLICY;
 
@@ -759,6 +766,10 @@ static inline int __inline__ ipv6_policy(struct __sk_buff *skb, int ifindex, __u
 		if (IS_ERR(ret))
 			return ret;
 
+		/* Only report flows which also passed the L4 policy */
+		send_flow_notify(skb, src_label, SECLABEL,
+				 ct_state_new.orig_dport, nexthdr);
+
 		ct_state.proxy_port = ct_state_new.proxy_port;
 	}
 
@@ -844,6 +855,9 @@ static inline int __inline__ ipv4_policy(struct __sk_buff *skb, int ifindex, __u
 	 * passed through the allowed consumer. */
 	verdict = policy_can_access(&POLICY_MAP, skb, src_label);
 	if (unlikely(ret == CT_NEW)) {
+		/* The tuple is invalidated by ct_create4() */
+		__u8 nexthdr = tuple.nexthdr;
+
 		if (verdict != TC_ACT_OK)
 			return DROP_POLICY;
 
@@ -852,6 +866,10 @@ static inline int __inline__ ipv4_policy(struct __sk_buff *skb, int ifindex, __u
 		if (IS_ERR(ret))
 			return ret;
 
+		/* Only report flows which also passed the L4 policy */
+		send_flow_notify(skb, src_label, SECLABEL,
+				 ct_state_new.orig_dport, nexthdr);
+
 		/* NOTE: tuple has been invalidated after this */
 
 		ct_state.proxy_port = ct_state_new.proxy_port;
@@ -909,6 +927,7 @@ __section_tail(CILIUM_MAP_POLICY, LXC_ID) int handle_policy(struct __sk_buff *sk
 	ifindex = skb->cb[CB_IFINDEX];
 
 	cilium_trace_capture(skb, DBG_CAPTURE_DELIVERY, ifindex);
+	mirror_packet(skb);
 
 	if (ifindex)
 		return redirect(ifindex, 0);
@@ -87,6 +87,7 @@ enum {
 	CILIUM_NOTIFY_DROP,
 	CILIUM_NOTIFY_DBG_MSG,
 	CILIUM_NOTIFY_DBG_CAPTURE,
+	CILIUM_NOTIFY_FLOW,
 };
 
 #define NOTIFY_COMMON_HDR \
@@ -105,6 +106,15 @@ struct drop_notify {
 	__u32		ifindex;
 };
 
+struct flow_notify {
+	NOTIFY_COMMON_HDR
+	__u32		src_label;
+	__u32		dst_label;
+	__u16		dport;
+	__u8		nexthdr;
+	__u8		pad;
+};
+
 #ifndef BPF_F_PSEUDO_HDR
 # define BPF_F_PSEUDO_HDR                (1ULL << 4)
 #endif
@@ -209,12 +219,16 @@ struct lb6_key {
         union v6addr address;
         __u16 dport;		/* L4 port filter, if unset, all ports apply */
 	__u16 slave;		/* Backend iterator, 0 indicates the master service */
+	__u8 proto;		/* L4 protocol filter, if unset, all protocols apply */
+	__u8 pad;
 } __attribute__((packed));
 
 struct lb6_service {
 	union v6addr target;
 	__u16 port;
-	__u16 count;
+	__u16 count;		/* Master: number of slaves, slave: if set, the
+				 * backend is draining and new connections are
+				 * redirected to this slave */
 	__u16 rev_nat_index;
 	__u16 weight;
 } __attribute__((packed));
@@ -228,12 +242,16 @@ struct lb4_key {
 	__be32 address;
         __u16 dport;		/* L4 port filter, if unset, all ports apply */
 	__u16 slave;		/* Backend iterator, 0 indicates the master service */
+	__u8 proto;		/* L4 protocol filter, if unset, all protocols apply */
+	__u8 pad;
 } __attribute__((packed));
 
 struct lb4_service {
 	__be32 target;
 	__u16 port;
-	__u16 count;
+	__u16 count;		/* Master: number of slaves, slave: if set, the
+				 * backend is draining and new connections are
+				 * redirected to this slave */
 	__u16 rev_nat_index;
 	__u16 weight;
 } __attribute__((packed));
@@ -237,6 +237,25 @@ static inline int __inline__ ct_lookup6(void *map, struct ipv6_ct_tuple *tuple,
 		action = ACTION_CREATE;
 		break;
 
+	case IPPROTO_SCTP:
+		if (1) {
+			__u8 chunk_type;
+
+			if (skb_load_bytes(skb, l4_off + SCTP_CHUNK_TYPE_OFF, &chunk_type, 1) < 0)
+				return DROP_CT_INVALID_HDR;
+
+			if (unlikely(chunk_type == SCTP_CHUNK_ABORT ||
+				     chunk_type == SCTP_CHUNK_SHUTDOWN_COMPLETE))
+				action = ACTION_DELETE;
+			else
+				action = ACTION_CREATE;
+		}
+
+		/* Port offsets for UDP and SCTP are the same */
+		if (skb_load_bytes(skb, l4_off, &tuple->dport, 4) < 0)
+			return DROP_CT_INVALID_HDR;
+		break;
+
 	default:
 		/* Can't handle extension headers yet */
 		return DROP_CT_UNKNOWN_PROTO;
@@ -397,6 +416,25 @@ static inline int __inline__ ct_lookup4(void *map, struct ipv4_ct_tuple *tuple,
 		action = ACTION_CREATE;
 		break;
 
+	case IPPROTO_SCTP:
+		if (1) {
+			__u8 chunk_type;
+
+			if (skb_load_bytes(skb, off + SCTP_CHUNK_TYPE_OFF, &chunk_type, 1) < 0)
+				return DROP_CT_INVALID_HDR;
+
+			if (unlikely(chunk_type == SCTP_CHUNK_ABORT ||
+				     chunk_type == SCTP_CHUNK_SHUTDOWN_COMPLETE))
+				action = ACTION_DELETE;
+			else
+				action = ACTION_CREATE;
+		}
+
+		/* Port offsets for UDP and SCTP are the same */
+		if (skb_load_bytes(skb, off, &tuple->dport, 4) < 0)
+			return DROP_CT_INVALID_HDR;
+		break;
+
 	default:
 		/* Can't handle extension headers yet */
 		return DROP_CT_UNKNOWN_PROTO;
@@ -451,7 +489,8 @@ static inline int __inline__ ct_create6(void *map, struct ipv6_ct_tuple *tuple,
 
 	if (dir == CT_INGRESS) {
 		if (tuple->nexthdr == IPPROTO_UDP ||
-		    tuple->nexthdr == IPPROTO_TCP) {
+		    tuple->nexthdr == IPPROTO_TCP ||
+		    tuple->nexthdr == IPPROTO_SCTP) {
 			/* Resolve L4 policy. This may fail due to policy reasons. May
 			 * optonally return a proxy port number to redirect all traffic to.
 			 */
@@ -474,7 +513,8 @@ static inline int __inline__ ct_create6(void *map, struct ipv6_ct_tuple *tuple,
 		entry.rx_bytes = skb->len;
 	} else {
 		if (tuple->nexthdr == IPPROTO_UDP ||
-		    tuple->nexthdr == IPPROTO_TCP) {
+		    tuple->nexthdr == IPPROTO_TCP ||
+		    tuple->nexthdr == IPPROTO_SCTP) {
 			/* Resolve L4 policy. This may fail due to policy reasons. May
 			 * optonally return a proxy port number to redirect all traffic to.
 			 */
@@ -553,7 +593,8 @@ static inline int __inline__ ct_create4(void *map, struct ipv4_ct_tuple *tuple,
 
 	if (dir == CT_INGRESS) {
 		if (tuple->nexthdr == IPPROTO_UDP ||
-		    tuple->nexthdr == IPPROTO_TCP) {
+		    tuple->nexthdr == IPPROTO_TCP ||
+		    tuple->nexthdr == IPPROTO_SCTP) {
 			cilium_trace(skb, DBG_GENERIC, ct_state->orig_dport, tuple->nexthdr);
 			/* Resolve L4 policy. This may fail due to policy reasons. May
 			 * optonally return a proxy port number to redirect all traffic to.
@@ -569,7 +610,8 @@ static inline int __inline__ ct_create4(void *map, struct ipv4_ct_tuple *tuple,
 		entry.rx_bytes = skb->len;
 	} else {
 		if (tuple->nexthdr == IPPROTO_UDP ||
-		    tuple->nexthdr == IPPROTO_TCP) {
+		    tuple->nexthdr == IPPROTO_TCP ||
+		    tuple->nexthdr == IPPROTO_SCTP) {
 			/* Resolve L4 policy. This may fail due to policy reasons. May
 			 * optonally return a proxy port number to redirect all traffic to.
 			 */
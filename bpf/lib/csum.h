@@ -60,6 +60,10 @@ static inline void csum_l4_offset_and_flags(__u8 nexthdr, struct csum_offset *of
 
 	case IPPROTO_ICMP:
 		break;
+
+	case IPPROTO_SCTP:
+		/* CRC32c does not cover a pseudo header */
+		break;
 	}
 }
 
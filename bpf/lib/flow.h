@@ -0,0 +1,68 @@
+/*
+ *  Copyright (C) 2017 Authors of Cilium
+ *
+ *  This program is free software; you can redistribute it and/or modify
+ *  it under the terms of the GNU General Public License as published by
+ *  the Free Software Foundation; either version 2 of the License, or
+ *  (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful,
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *  GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License
+ *  along with this program; if not, write to the Free Software
+ *  Foundation, Inc., 51 Franklin St, Fifth Floor, Boston, MA  02110-1301  USA
+ */
+/*
+ * Notification of new allowed connections via perf event ring buffer
+ *
+ * API:
+ * void send_flow_notify(skb, src, dst, dport, nexthdr)
+ *
+ * Reports the source and destination security identity as well as the
+ * destination port and L4 protocol of a connection which has been allowed
+ * by L3 and L4 policy, once its connection tracking entry has been created.
+ *
+ * If FLOW_NOTIFY is not defined, the API will be compiled in as a NOP.
+ */
+
+#ifndef __LIB_FLOW_H_
+#define __LIB_FLOW_H_
+
+#include <bpf/api.h>
+
+#include "events.h"
+#include "common.h"
+
+#ifdef FLOW_NOTIFY
+
+static inline void __inline__ send_flow_notify(struct __sk_buff *skb, __u32 src,
+					       __u32 dst, __u16 dport,
+					       __u8 nexthdr)
+{
+	struct flow_notify msg = {
+		.type = CILIUM_NOTIFY_FLOW,
+		.source = EVENT_SOURCE,
+		.hash = get_hash_recalc(skb),
+		.src_label = src,
+		.dst_label = dst,
+		.dport = dport,
+		.nexthdr = nexthdr,
+	};
+
+	skb_event_output(skb, &cilium_events, BPF_F_CURRENT_CPU, &msg, sizeof(msg));
+}
+
+#else /* FLOW_NOTIFY */
+
+static inline void __inline__ send_flow_notify(struct __sk_buff *skb, __u32 src,
+					       __u32 dst, __u16 dport,
+					       __u8 nexthdr)
+{
+}
+
+#endif /* FLOW_NOTIFY */
+
+#endif /* __LIB_FLOW_H_ */
@@ -28,6 +28,22 @@
 #define TCP_SPORT_OFF (offsetof(struct tcphdr, source))
 #define UDP_DPORT_OFF (offsetof(struct udphdr, dest))
 #define UDP_SPORT_OFF (offsetof(struct udphdr, source))
+#define TCP_FLAGS_OFF 13
+
+#define TCP_FLAG_SYN_BIT 0x02
+#define TCP_FLAG_ACK_BIT 0x10
+
+/* SCTP common header followed by the type of the first chunk. SCTP is
+ * protected by a CRC32c checksum which does not cover a pseudo header, port
+ * numbers can thus not be rewritten without recalculating the checksum
+ * over the entire packet. */
+#define SCTP_SPORT_OFF		0
+#define SCTP_DPORT_OFF		2
+#define SCTP_CHUNK_TYPE_OFF	12
+
+#define SCTP_CHUNK_INIT			1
+#define SCTP_CHUNK_ABORT		6
+#define SCTP_CHUNK_SHUTDOWN_COMPLETE	14
 
 
 /**
@@ -131,7 +147,7 @@ struct l4_allow
 	/* If defined, will redirect all traffic to this proxy port */
 	__u16 proxy;
 
-	/* Allowed nexthdr (IPPROTO_ICMP, IPPROTO_TCP, IPPROTO_UDP) */
+	/* Allowed nexthdr (IPPROTO_ICMP, IPPROTO_TCP, IPPROTO_UDP, IPPROTO_SCTP) */
 	__u8 nexthdr;
 };
 
@@ -174,6 +174,32 @@ static inline int lb4_select_slave(struct __sk_buff *skb,
 	return slave;
 }
 
+/**
+ * Returns true if the packet may open a new connection. Connection setup can
+ * only be detected for TCP and SCTP without a conntrack lookup, packets of
+ * all other protocols are considered to open a new connection.
+ */
+static inline int __inline__ lb_is_new_conn(struct __sk_buff *skb, __u8 nexthdr,
+					    int l4_off)
+{
+	__u8 tmp;
+
+	switch (nexthdr) {
+	case IPPROTO_TCP:
+		if (skb_load_bytes(skb, l4_off + TCP_FLAGS_OFF, &tmp, 1) < 0)
+			return 1;
+		return (tmp & (TCP_FLAG_SYN_BIT | TCP_FLAG_ACK_BIT)) == TCP_FLAG_SYN_BIT;
+
+	case IPPROTO_SCTP:
+		if (skb_load_bytes(skb, l4_off + SCTP_CHUNK_TYPE_OFF, &tmp, 1) < 0)
+			return 1;
+		return tmp == SCTP_CHUNK_INIT;
+
+	default:
+		return 1;
+	}
+}
+
 static inline int __inline__ extract_l4_port(struct __sk_buff *skb, __u8 nexthdr,
 					     int l4_off, __u16 *port)
 {
@@ -182,7 +208,8 @@ static inline int __inline__ extract_l4_port(struct __sk_buff *skb, __u8 nexthdr
 	switch (nexthdr) {
 	case IPPROTO_TCP:
 	case IPPROTO_UDP:
-		/* Port offsets for UDP and TCP are the same */
+	case IPPROTO_SCTP:
+		/* Port offsets for UDP, TCP and SCTP are the same */
 		ret = l4_load_port(skb, l4_off + TCP_DPORT_OFF, port);
 		if (IS_ERR(ret))
 			return ret;
@@ -225,6 +252,8 @@ static inline int __inline__ reverse_map_l4_port(struct __sk_buff *skb, __u8 nex
 		}
 		break;
 
+	case IPPROTO_SCTP:
+		/* SCTP services never translate the port, see lb*_xlate() */
 	case IPPROTO_ICMPV6:
 	case IPPROTO_ICMP:
 		break;
@@ -278,7 +307,8 @@ static inline int __inline__ __lb6_rev_nat(struct __sk_buff *skb, int l4_off,
 		return DROP_WRITE_ERROR;
 
 	sum = csum_diff(old_saddr.addr, 16, new_saddr, 16, 0);
-	if (csum_l4_replace(skb, l4_off, csum_off, 0, sum, BPF_F_PSEUDO_HDR) < 0)
+	if (csum_off->offset &&
+	    csum_l4_replace(skb, l4_off, csum_off, 0, sum, BPF_F_PSEUDO_HDR) < 0)
 		return DROP_CSUM_L4;
 
 	return 0;
@@ -336,6 +366,7 @@ static inline int __inline__ lb6_extract_key(struct __sk_buff *skb, struct ipv6_
 	csum_l4_offset_and_flags(tuple->nexthdr, csum_off);
 
 #ifdef LB_L4
+	key->proto = tuple->nexthdr;
 	return extract_l4_port(skb, tuple->nexthdr, l4_off, &key->dport);
 #else
 	return 0;
@@ -354,6 +385,14 @@ static inline struct lb6_service *lb6_lookup_service(struct __sk_buff *skb,
 		if (svc && svc->count != 0)
 			return svc;
 
+		/* Fall back to a service matching all protocols */
+		if (key->proto) {
+			key->proto = 0;
+			svc = map_lookup_elem(&cilium_lb6_services, key);
+			if (svc && svc->count != 0)
+				return svc;
+		}
+
 		key->dport = 0;
 	}
 #endif
@@ -362,6 +401,7 @@ static inline struct lb6_service *lb6_lookup_service(struct __sk_buff *skb,
 	if (1) {
 		struct lb6_service *svc;
 
+		key->proto = 0;
 		cilium_trace_lb(skb, DBG_LB6_LOOKUP_MASTER, key->address.p4, key->dport);
 		svc = map_lookup_elem(&cilium_lb6_services, key);
 		if (svc && svc->count != 0)
@@ -389,13 +429,38 @@ static inline struct lb6_service *lb6_lookup_slave(struct __sk_buff *skb,
 	return NULL;
 }
 
+/** Look up a slave for the packet skipping draining slaves for new connections
+ * @arg skb		packet
+ * @arg key		service key
+ * @arg slave		selected slave
+ * @arg nexthdr		L4 protocol
+ * @arg l4_off		offset to L4
+ *
+ * Draining slaves only serve established connections, new connections are
+ * redirected to the slave stored in the count field of the draining slave.
+ */
+static inline struct lb6_service *lb6_lookup_active_slave(struct __sk_buff *skb,
+							  struct lb6_key *key, __u16 slave,
+							  __u8 nexthdr, int l4_off)
+{
+	struct lb6_service *svc;
+
+	if (!(svc = lb6_lookup_slave(skb, key, slave)))
+		return NULL;
+
+	if (unlikely(svc->count) && lb_is_new_conn(skb, nexthdr, l4_off))
+		svc = lb6_lookup_slave(skb, key, svc->count);
+
+	return svc;
+}
+
 static inline int __inline__ lb6_xlate(struct __sk_buff *skb, union v6addr *new_dst, __u8 nexthdr,
 				       int l3_off, int l4_off, struct csum_offset *csum_off,
 				       struct lb6_key *key, struct lb6_service *svc)
 {
 	ipv6_store_daddr(skb, new_dst->addr, l3_off);
 
-	if (csum_off) {
+	if (csum_off && csum_off->offset) {
 		__be32 sum = csum_diff(key->address.addr, 16, new_dst->addr, 16, 0);
 		if (csum_l4_replace(skb, l4_off, csum_off, 0, sum, BPF_F_PSEUDO_HDR) < 0)
 			return DROP_CSUM_L4;
@@ -426,7 +491,7 @@ static inline int __inline__ lb6_local(struct __sk_buff *skb, int l3_off, int l4
 	union v6addr *addr;
 
 	slave = lb6_select_slave(skb, key, svc->count, svc->weight);
-	if (!(svc = lb6_lookup_slave(skb, key, slave)))
+	if (!(svc = lb6_lookup_active_slave(skb, key, slave, tuple->nexthdr, l4_off)))
 		return DROP_NO_SERVICE;
 
 #ifdef CONNTRACK_LOCAL
@@ -570,6 +635,7 @@ static inline int __inline__ lb4_extract_key(struct __sk_buff *skb, struct ipv4_
 	csum_l4_offset_and_flags(tuple->nexthdr, csum_off);
 
 #ifdef LB_L4
+	key->proto = tuple->nexthdr;
 	return extract_l4_port(skb, tuple->nexthdr, l4_off, &key->dport);
 #else
 	return 0;
@@ -589,6 +655,14 @@ static inline struct lb4_service *lb4_lookup_service(struct __sk_buff *skb,
 		if (svc && svc->count != 0)
 			return svc;
 
+		/* Fall back to a service matching all protocols */
+		if (key->proto) {
+			key->proto = 0;
+			svc = map_lookup_elem(&cilium_lb4_services, key);
+			if (svc && svc->count != 0)
+				return svc;
+		}
+
 		key->dport = 0;
 	}
 #endif
@@ -597,6 +671,7 @@ static inline struct lb4_service *lb4_lookup_service(struct __sk_buff *skb,
 	if (1) {
 		struct lb4_service *svc;
 
+		key->proto = 0;
 		/* FIXME: The verifier barks on these calls right now for some reason */
 		/* cilium_trace_lb(skb, DBG_LB4_LOOKUP_MASTER, key->address, key->dport); */
 		svc = map_lookup_elem(&cilium_lb4_services, key);
@@ -625,6 +700,31 @@ static inline struct lb4_service *lb4_lookup_slave(struct __sk_buff *skb,
 	return NULL;
 }
 
+/** Look up a slave for the packet skipping draining slaves for new connections
+ * @arg skb		packet
+ * @arg key		service key
+ * @arg slave		selected slave
+ * @arg nexthdr		L4 protocol
+ * @arg l4_off		offset to L4
+ *
+ * Draining slaves only serve established connections, new connections are
+ * redirected to the slave stored in the count field of the draining slave.
+ */
+static inline struct lb4_service *lb4_lookup_active_slave(struct __sk_buff *skb,
+							  struct lb4_key *key, __u16 slave,
+							  __u8 nexthdr, int l4_off)
+{
+	struct lb4_service *svc;
+
+	if (!(svc = lb4_lookup_slave(skb, key, slave)))
+		return NULL;
+
+	if (unlikely(svc->count) && lb_is_new_conn(skb, nexthdr, l4_off))
+		svc = lb4_lookup_slave(skb, key, svc->count);
+
+	return svc;
+}
+
 static inline int __inline__
 lb4_xlate(struct __sk_buff *skb, __be32 *new_daddr, __be32 *new_saddr,
 	  __be32 *old_saddr, __u8 nexthdr, int l3_off, int l4_off,
@@ -681,7 +781,7 @@ static inline int __inline__ lb4_local(struct __sk_buff *skb, int l3_off, int l4
 	__u16 slave;
 
 	slave = lb4_select_slave(skb, key, svc->count, svc->weight);
-	if (!(svc = lb4_lookup_slave(skb, key, slave)))
+	if (!(svc = lb4_lookup_active_slave(skb, key, slave, tuple->nexthdr, l4_off)))
 		return DROP_NO_SERVICE;
 
 	state->rev_nat_index = svc->rev_nat_index;
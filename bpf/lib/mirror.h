@@ -0,0 +1,104 @@
+/*
+ *  Copyright (C) 2017 Authors of Cilium
+ *
+ *  This program is free software; you can redistribute it and/or modify
+ *  it under the terms of the GNU General Public License as published by
+ *  the Free Software Foundation; either version 2 of the License, or
+ *  (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful,
+ *  but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *  GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License
+ *  along with this program; if not, write to the Free Software
+ *  Foundation, Inc., 51 Franklin St, Fifth Floor, Boston, MA  02110-1301  USA
+ */
+/*
+ * Traffic mirroring to a collector interface
+ *
+ * API:
+ * void mirror_packet(skb)
+ *
+ * Clones the packet to MIRROR_IFINDEX. One out of MIRROR_SAMPLE_RATE
+ * packets is mirrored and at most MIRROR_RATE_LIMIT packets are mirrored
+ * per second and CPU. A MIRROR_RATE_LIMIT of 0 disables rate limiting.
+ *
+ * The clone is not encapsulated, MIRROR_IFINDEX must be a tunnel device to
+ * reach a remote collector.
+ *
+ * If ENABLE_MIRROR is not defined, the API will be compiled in as a NOP.
+ */
+
+#ifndef __LIB_MIRROR_H_
+#define __LIB_MIRROR_H_
+
+#include <bpf/api.h>
+
+#include "common.h"
+
+#ifdef ENABLE_MIRROR
+
+#define MIRROR_INTERVAL_NS 1000000000ULL
+
+struct mirror_state {
+	__u64 start;
+	__u64 packets;
+};
+
+struct bpf_elf_map __section_maps cilium_mirror = {
+	.type		= BPF_MAP_TYPE_PERCPU_ARRAY,
+	.size_key	= sizeof(__u32),
+	.size_value	= sizeof(struct mirror_state),
+	.pinning	= PIN_GLOBAL_NS,
+	.max_elem	= 1,
+};
+
+static inline int __inline__ mirror_rate_limited(void)
+{
+#if MIRROR_RATE_LIMIT > 0
+	struct mirror_state *state;
+	__u32 key = 0;
+	__u64 now;
+
+	state = map_lookup_elem(&cilium_mirror, &key);
+	if (!state)
+		return 1;
+
+	now = ktime_get_ns();
+	if (now - state->start > MIRROR_INTERVAL_NS) {
+		state->start = now;
+		state->packets = 0;
+	}
+
+	if (state->packets >= MIRROR_RATE_LIMIT)
+		return 1;
+
+	state->packets++;
+#endif
+	return 0;
+}
+
+static inline void __inline__ mirror_packet(struct __sk_buff *skb)
+{
+#if MIRROR_SAMPLE_RATE > 1
+	if (get_prandom_u32() % MIRROR_SAMPLE_RATE)
+		return;
+#endif
+
+	if (mirror_rate_limited())
+		return;
+
+	clone_redirect(skb, MIRROR_IFINDEX, 0);
+}
+
+#else /* ENABLE_MIRROR */
+
+static inline void __inline__ mirror_packet(struct __sk_buff *skb)
+{
+}
+
+#endif /* ENABLE_MIRROR */
+
+#endif /* __LIB_MIRROR_H_ */
@@ -42,3 +42,8 @@
 #define CONNTRACK
 #define CFG_L4_INGRESS { {80, 8080, 0} }
 #define CFG_L4_EGRESS { {80, 8080, 0} }
+#define ENABLE_MIRROR
+#define FLOW_NOTIFY
+#define MIRROR_IFINDEX 1
+#define MIRROR_SAMPLE_RATE 1
+#define MIRROR_RATE_LIMIT 1000
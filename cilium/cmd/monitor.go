@@ -37,6 +37,7 @@ var monitorCmd = &cobra.Command{
 programs attached to endpoints and devices. This includes:
   * Dropped packet notifications
   * Captured packet traces
+  * New allowed connections
   * Debugging information`,
 	Run: func(cmd *cobra.Command, args []string) {
 		runMonitor()
@@ -77,6 +78,7 @@ var (
 		"drop":    bpfdebug.MessageTypeDrop,
 		"debug":   bpfdebug.MessageTypeDebug,
 		"capture": bpfdebug.MessageTypeCapture,
+		"flow":    bpfdebug.MessageTypeFlow,
 	}
 	fromSource = uint16(0)
 	toDst      = uint32(0)
@@ -141,6 +143,18 @@ func captureEvents(prefix string, data []byte) {
 	}
 }
 
+// flowEvents prints out all the new connection notifications.
+func flowEvents(prefix string, data []byte) {
+	fn := bpfdebug.FlowNotify{}
+
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, &fn); err != nil {
+		fmt.Printf("Error while parsing flow notification message: %s\n", err)
+	}
+	if match(bpfdebug.MessageTypeFlow, fn.Source, 0) {
+		fn.Dump(prefix)
+	}
+}
+
 // receiveEvent forwards all the per CPU events to the appropriate type function.
 func receiveEvent(msg *bpf.PerfEventSample, cpu int) {
 	prefix := fmt.Sprintf("CPU %02d:", cpu)
@@ -154,6 +168,8 @@ func receiveEvent(msg *bpf.PerfEventSample, cpu int) {
 		debugEvents(prefix, data)
 	case bpfdebug.MessageTypeCapture:
 		captureEvents(prefix, data)
+	case bpfdebug.MessageTypeFlow:
+		flowEvents(prefix, data)
 	default:
 		fmt.Printf("%s Unknonwn event: %+v\n", prefix, msg)
 	}
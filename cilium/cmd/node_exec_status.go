@@ -0,0 +1,134 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/models"
+	clientPkg "github.com/cilium/cilium/pkg/client"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	execStatusAll     bool
+	execStatusTimeout time.Duration
+)
+
+// nodeExecStatusCmd represents the node_exec-status command
+var nodeExecStatusCmd = &cobra.Command{
+	Use:   "exec-status [<node>...]",
+	Short: "Display status of the agents of multiple nodes",
+	Long: `Retrieves the status from the read-only API of the agents running on the
+given nodes or, with --all, on all nodes registered in the key-value store.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if !execStatusAll && len(args) == 0 {
+			Usagef(cmd, "Specify nodes or use --all")
+		}
+		execStatus(args)
+	},
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeExecStatusCmd)
+	nodeExecStatusCmd.Flags().BoolVar(&execStatusAll, "all", false, "Query all nodes")
+	nodeExecStatusCmd.Flags().DurationVar(&execStatusTimeout, "timeout", 10*time.Second,
+		"Timeout to retrieve the status of a single node")
+}
+
+// getNodeStatus retrieves the status of the agent of the given node
+func getNodeStatus(node *models.Node) (*models.StatusResponse, error) {
+	if node.APIAddress == "" {
+		return nil, fmt.Errorf("agent does not serve the read-only API over TCP")
+	}
+
+	c, err := clientPkg.NewTLSClient(node.APIAddress, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.StatusGet(execStatusTimeout)
+}
+
+func statusState(s *models.Status) string {
+	if s == nil {
+		return "-"
+	}
+	return s.State
+}
+
+func execStatus(names []string) {
+	nodes, err := client.NodeList()
+	if err != nil {
+		Fatalf("Cannot get node list: %s", err)
+	}
+
+	if !execStatusAll {
+		registered := map[string]*models.Node{}
+		for _, node := range nodes {
+			registered[node.Name] = node
+		}
+
+		nodes = nodes[:0]
+		for _, name := range names {
+			node, ok := registered[name]
+			if !ok {
+				Fatalf("Node %s is not registered", name)
+			}
+			nodes = append(nodes, node)
+		}
+	}
+
+	statuses := make([]*models.StatusResponse, len(nodes))
+	errs := make([]error, len(nodes))
+
+	var wg sync.WaitGroup
+	wg.Add(len(nodes))
+	for i := range nodes {
+		go func(i int) {
+			statuses[i], errs[i] = getNodeStatus(nodes[i])
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	failed := false
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+	fmt.Fprintf(w, "NODE\tCILIUM\tKVSTORE\tCONTAINER-RUNTIME\tKUBERNETES\t\n")
+	for i, node := range nodes {
+		if errs[i] != nil {
+			fmt.Fprintf(w, "%s\tError: %s\t\n", node.Name, errs[i])
+			failed = true
+			continue
+		}
+
+		sr := statuses[i]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n", node.Name, statusState(sr.Cilium),
+			statusState(sr.Kvstore), statusState(sr.ContainerRuntime), statusState(sr.Kubernetes))
+		if sr.Cilium == nil || sr.Cilium.State != models.StatusStateOk {
+			failed = true
+		}
+	}
+	w.Flush()
+
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+// nodeListCmd represents the node_list command
+var nodeListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List nodes registered in the key-value store",
+	Run: func(cmd *cobra.Command, args []string) {
+		listNodes()
+	},
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeListCmd)
+}
+
+func listNodes() {
+	nodes, err := client.NodeList()
+	if err != nil {
+		Fatalf("Cannot get node list: %s", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+	fmt.Fprintf(w, "NAME\tAPI ADDRESS\t\n")
+	for _, node := range nodes {
+		fmt.Fprintf(w, "%s\t%s\t\n", node.Name, node.APIAddress)
+	}
+	w.Flush()
+}
@@ -0,0 +1,163 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"time"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/bpfdebug"
+	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/policy"
+	"github.com/cilium/cilium/pkg/u8proto"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sys/unix"
+)
+
+// policySuggestCmd represents the policy_suggest command
+var policySuggestCmd = &cobra.Command{
+	Use:   "suggest --from-flows <duration>",
+	Short: "Suggest a policy allowing the connections observed on this node",
+	Long: `Collects the notifications of new allowed connections emitted by all local
+endpoints with the FlowNotification option enabled for the given duration and
+prints a candidate policy allowing exactly the observed connections between
+security identities. The policy should be reviewed before it is imported.`,
+	Example: "policy suggest --from-flows 10m > policy.json",
+	Run: func(cmd *cobra.Command, args []string) {
+		if suggestDuration <= 0 {
+			Usagef(cmd, "Missing or invalid --from-flows duration")
+		}
+		suggestPolicy(suggestDuration)
+	},
+}
+
+var suggestDuration time.Duration
+
+func init() {
+	policyCmd.AddCommand(policySuggestCmd)
+	policySuggestCmd.Flags().DurationVar(&suggestDuration, "from-flows", 0,
+		"Duration during which allowed connections are observed")
+}
+
+// collectFlows aggregates the flow notifications received for duration d or
+// until interrupted.
+func collectFlows(d time.Duration) (policy.FlowSet, error) {
+	events, err := bpf.NewPerCpuEvents(bpf.DefaultPerfEventConfig())
+	if err != nil {
+		return nil, err
+	}
+	defer events.CloseAll()
+
+	flows := policy.NewFlowSet()
+	receive := func(msg *bpf.PerfEventSample, cpu int) {
+		data := msg.DataDirect()
+		if data[0] != bpfdebug.MessageTypeFlow {
+			return
+		}
+
+		fn := bpfdebug.FlowNotify{}
+		if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, &fn); err != nil {
+			fmt.Fprintf(os.Stderr, "Error while parsing flow notification message: %s\n", err)
+			return
+		}
+
+		flows.Add(policy.Flow{
+			Src:     policy.NumericIdentity(fn.SrcLabel),
+			Dst:     policy.NumericIdentity(fn.DstLabel),
+			DstPort: fn.DstPort(),
+			Proto:   u8proto.U8proto(fn.Nexthdr),
+		})
+	}
+	lost := func(msg *bpf.PerfEventLost, cpu int) {}
+
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, os.Interrupt)
+	defer signal.Stop(signalChan)
+
+	fmt.Fprintf(os.Stderr, "Observing connections for %s, press Ctrl-C to stop early\n", d)
+
+	deadline := time.Now().Add(d)
+	for time.Now().Before(deadline) {
+		select {
+		case <-signalChan:
+			deadline = time.Now()
+			continue
+		default:
+		}
+
+		todo, err := events.Poll(1000)
+		if err != nil && err != unix.EINTR {
+			return nil, err
+		}
+		if todo > 0 {
+			if err := events.ReadAll(receive, lost); err != nil {
+				fmt.Fprintf(os.Stderr, "Error received while reading from perf buffer: %s\n", err)
+			}
+		}
+	}
+
+	if lostEvents, _ := events.Stats(); lostEvents != 0 {
+		fmt.Fprintf(os.Stderr, "Warning: %d notifications lost, the suggested policy may be incomplete\n", lostEvents)
+	}
+
+	return flows, nil
+}
+
+// identityLabels resolves the labels of a security identity via the API
+func identityLabels(id policy.NumericIdentity) (labels.Labels, error) {
+	identity, err := client.IdentityGet(strconv.Itoa(int(id)))
+	if err != nil {
+		return nil, err
+	}
+	return labels.NewLabelsFromModel(identity.Labels), nil
+}
+
+func suggestPolicy(d time.Duration) {
+	if os.Getuid() != 0 {
+		Fatalf("Please run with root privileges to observe connections.\n")
+	}
+
+	flows, err := collectFlows(d)
+	if err != nil {
+		Fatalf("Unable to observe connections: %s\n", err)
+	}
+
+	if len(flows) == 0 {
+		Fatalf("No connections observed, is FlowNotification enabled on the endpoints?\n")
+	}
+
+	rules, err := flows.SuggestRules(identityLabels)
+	if err != nil {
+		Fatalf("Unable to suggest policy: %s\n", err)
+	}
+
+	if len(rules) == 0 {
+		Fatalf("No TCP, UDP or SCTP connections observed\n")
+	}
+
+	jsonPolicy, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		Fatalf("Cannot marshal policy: %s\n", err)
+	}
+	fmt.Println(string(jsonPolicy))
+}
@@ -35,7 +35,7 @@ var policyTraceCmd = &cobra.Command{
 	Long: `Verifies if source ID or LABEL(s) is allowed to consume
 destination ID or LABEL(s). LABEL is represented as
 SOURCE:KEY[=VALUE].
-dports can be can be for example: 80/tcp, 53 or 23/udp.`,
+dports can be can be for example: 80/tcp, 53, 23/udp or 36412/sctp.`,
 	PreRun: verifyPolicyTrace,
 	Run: func(cmd *cobra.Command, args []string) {
 		srcSlice, err := parseAllowedSlice(src)
@@ -119,7 +119,7 @@ func parseAllowedSlice(slice []string) ([]string, error) {
 
 // parseL4PortsSlice parses a given `slice` of strings. Each string should be in
 // the form of `<port>[/<protocol>]`, where the `<port>` in an integer and an
-// `<protocol>` is an optional layer 4 protocol `tcp` or `udp`. In case
+// `<protocol>` is an optional layer 4 protocol `tcp`, `udp` or `sctp`. In case
 // `protocol` is not present, or is set to `any`, the parsed port will be set to
 // `models.PortProtocolAny`.
 func parseL4PortsSlice(slice []string) ([]*models.Port, error) {
@@ -133,7 +133,7 @@ func parseL4PortsSlice(slice []string) ([]*models.Port, error) {
 		case 2:
 			protoStr = strings.ToLower(vSplit[1])
 			switch protoStr {
-			case models.PortProtocolTCP, models.PortProtocolUDP, models.PortProtocolAny:
+			case models.PortProtocolTCP, models.PortProtocolUDP, models.PortProtocolSCTP, models.PortProtocolAny:
 			default:
 				return nil, fmt.Errorf("invalid protocol %q", protoStr)
 			}
@@ -15,6 +15,7 @@
 package cmd
 
 import (
+	"crypto/tls"
 	"fmt"
 	"os"
 
@@ -29,6 +30,9 @@ var (
 	cfgFile string
 	client  *clientPkg.Client
 	log     = logrus.New()
+
+	// tlsConfig is used to connect to remote agents, nil if TLS is disabled
+	tlsConfig *tls.Config
 )
 
 const (
@@ -77,6 +81,9 @@ func init() {
 	flags.StringVar(&cfgFile, "config", "", "config file (default is $HOME/.cilium.yaml)")
 	flags.BoolP("debug", "D", false, "Enable debug messages")
 	flags.StringP("host", "H", "", "URI to server-side API")
+	flags.String("tls-ca", "", "CA to verify the certificate of remote agents against")
+	flags.String("tls-cert", "", "Client certificate to authenticate with at remote agents")
+	flags.String("tls-key", "", "Private key of the client certificate")
 	viper.BindPFlags(flags)
 }
 
@@ -102,7 +109,16 @@ func initConfig() {
 		log.Level = logrus.InfoLevel
 	}
 
-	if cl, err := clientPkg.NewClient(viper.GetString("host")); err != nil {
+	caFile, certFile, keyFile := viper.GetString("tls-ca"), viper.GetString("tls-cert"), viper.GetString("tls-key")
+	if caFile != "" || certFile != "" || keyFile != "" {
+		cfg, err := clientPkg.NewTLSConfig(caFile, certFile, keyFile)
+		if err != nil {
+			Fatalf("Error while loading TLS configuration: %s\n", err)
+		}
+		tlsConfig = cfg
+	}
+
+	if cl, err := clientPkg.NewTLSClient(viper.GetString("host"), tlsConfig); err != nil {
 		Fatalf("Error while creating client: %s\n", err)
 	} else {
 		client = cl
@@ -32,6 +32,7 @@ var (
 	addRev   bool
 	idU      uint64
 	frontend string
+	protocol string
 	backends []string
 )
 
@@ -49,26 +50,31 @@ func init() {
 	serviceUpdateCmd.Flags().BoolVarP(&addRev, "rev", "", true, "Add reverse translation")
 	serviceUpdateCmd.Flags().Uint64VarP(&idU, "id", "", 0, "Identifier")
 	serviceUpdateCmd.Flags().StringVarP(&frontend, "frontend", "", "", "Frontend address")
+	serviceUpdateCmd.Flags().StringVarP(&protocol, "protocol", "", models.FrontendAddressProtocolTCP, "Frontend protocol { tcp | udp | sctp | any }")
 	serviceUpdateCmd.Flags().StringSliceVarP(&backends, "backends", "", []string{}, "Backend address or addresses followed by optional weight (<IP:Port>[/weight])")
 }
 
-func parseFrontendAddress(address string) (*models.FrontendAddress, net.IP) {
+func parseFrontendAddress(address, protocol string) (*models.FrontendAddress, net.IP) {
 	frontend, err := net.ResolveTCPAddr("tcp", address)
 	if err != nil {
 		Fatalf("Unable to parse frontend address: %s\n", err)
 	}
 
-	// FIXME support more than TCP
+	l4Type, err := types.NewL4Type(protocol)
+	if err != nil {
+		Fatalf("Unable to parse frontend protocol %q: %s\n", protocol, err)
+	}
+
 	return &models.FrontendAddress{
 		IP:       frontend.IP.String(),
 		Port:     uint16(frontend.Port),
-		Protocol: models.FrontendAddressProtocolTCP,
+		Protocol: l4Type.ModelProtocol(),
 	}, frontend.IP
 }
 
 func updateService(cmd *cobra.Command, args []string) {
 	id := int64(idU)
-	fa, faIP := parseFrontendAddress(frontend)
+	fa, faIP := parseFrontendAddress(frontend, protocol)
 
 	svc := &models.Service{
 		ID:               id,
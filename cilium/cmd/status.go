@@ -71,6 +71,11 @@ func statusDaemon(cmd *cobra.Command, args []string) {
 
 		w.Flush()
 
+		for _, o := range sr.DeprecatedOptions {
+			fmt.Fprintf(os.Stderr, "Warning: Option %s is deprecated and will be removed in %s, use %s instead\n",
+				o.Name, o.RemovedIn, o.Replacement)
+		}
+
 		if sr.Cilium != nil && sr.Cilium.State != models.StatusStateOk {
 			os.Exit(1)
 		} else {
@@ -38,6 +38,8 @@ const (
 	MaxSetOfServiceID = uint32(0xFFFF)
 	// FirstFreeServiceID is the first ID for which the services should be assigned.
 	FirstFreeServiceID = uint32(1)
+	// NodesKeyPath is the base path where the nodes running an agent are registered.
+	NodesKeyPath = OperationalPath + "/Nodes"
 
 	// Miscellaneous dedicated constants
 
@@ -30,6 +30,8 @@ const (
 	TCP = L4Type("TCP")
 	// UDP type.
 	UDP = L4Type("UDP")
+	// SCTP type.
+	SCTP = L4Type("SCTP")
 )
 
 // L4Type name.
@@ -77,6 +79,18 @@ func (s *LBSVC) GetModel() *models.Service {
 // and the value the LBSVC.
 type SVCMap map[string]LBSVC
 
+// FindAmbiguous returns a service with a frontend which is ambiguous with the
+// given frontend or nil if there is none. A service with the same ID is never
+// considered ambiguous as it is replaced by fe.
+func (svcs SVCMap) FindAmbiguous(fe L3n4AddrID) *LBSVC {
+	for _, svc := range svcs {
+		if svc.FE.ID != fe.ID && svc.FE.IsAmbiguous(&fe.L3n4Addr) {
+			return &svc
+		}
+	}
+	return nil
+}
+
 // SVCMapID maps service IDs to service structures.
 type SVCMapID map[ServiceID]*LBSVC
 
@@ -120,11 +134,25 @@ func NewL4Type(name string) (L4Type, error) {
 		return TCP, nil
 	case "udp":
 		return UDP, nil
+	case "sctp":
+		return SCTP, nil
+	case "any":
+		return NONE, nil
 	default:
 		return "", fmt.Errorf("Unknown L4 protocol")
 	}
 }
 
+// ModelProtocol returns the L4Type as protocol of the API model
+func (l L4Type) ModelProtocol() string {
+	switch l {
+	case NONE, "":
+		return models.FrontendAddressProtocolAny
+	default:
+		return strings.ToLower(string(l))
+	}
+}
+
 // NewLoadBalancer returns a LoadBalancer with all maps initialized.
 func NewLoadBalancer() *LoadBalancer {
 	return &LoadBalancer{
@@ -186,7 +214,7 @@ type L4Addr struct {
 // NewL4Addr creates a new L4Addr. Returns an error if protocol is not recognized.
 func NewL4Addr(protocol L4Type, number uint16) (*L4Addr, error) {
 	switch protocol {
-	case TCP, UDP, NONE:
+	case TCP, UDP, SCTP, NONE:
 	default:
 		return nil, fmt.Errorf("unknown protocol type %s", protocol)
 	}
@@ -313,7 +341,7 @@ func (a *L3n4Addr) GetModel() *models.FrontendAddress {
 
 	return &models.FrontendAddress{
 		IP:       a.IP.String(),
-		Protocol: string(a.Protocol),
+		Protocol: a.Protocol.ModelProtocol(),
 		Port:     a.Port,
 	}
 }
@@ -331,13 +359,19 @@ func (b *LBBackEnd) GetBackendModel() *models.BackendAddress {
 	}
 }
 
-// String returns the L3n4Addr in the "IPv4:Port" format for IPv4 and "[IPv6]:Port" format
-// for IPv6.
+// String returns the L3n4Addr in the "IPv4:Port/Protocol" format for IPv4 and
+// "[IPv6]:Port/Protocol" format for IPv6. The protocol is omitted if the address
+// matches all protocols.
 func (a *L3n4Addr) String() string {
+	var proto string
+	if a.Protocol != NONE && a.Protocol != "" {
+		proto = "/" + string(a.Protocol)
+	}
+
 	if a.IsIPv6() {
-		return fmt.Sprintf("[%s]:%d", a.IP.String(), a.Port)
+		return fmt.Sprintf("[%s]:%d%s", a.IP.String(), a.Port, proto)
 	}
-	return fmt.Sprintf("%s:%d", a.IP.String(), a.Port)
+	return fmt.Sprintf("%s:%d%s", a.IP.String(), a.Port, proto)
 }
 
 // DeepCopy returns a DeepCopy of the given L3n4Addr.
@@ -352,17 +386,20 @@ func (a *L3n4Addr) DeepCopy() *L3n4Addr {
 
 // SHA256Sum calculates L3n4Addr's internal SHA256Sum.
 func (a L3n4Addr) SHA256Sum() string {
-	// FIXME: Remove Protocol's omission once we care about protocols.
-	protoBak := a.Protocol
-	a.Protocol = ""
-	defer func() {
-		a.Protocol = protoBak
-	}()
-
 	str := []byte(fmt.Sprintf("%+v", a))
 	return fmt.Sprintf("%x", sha512.New512_256().Sum(str))
 }
 
+// IsAmbiguous returns true if a and b share the same IP and port but only one
+// of them matches all protocols, e.g. 10.0.0.1:80 and 10.0.0.1:80/TCP. TCP and
+// UDP services with the same IP and port are distinct and not ambiguous.
+func (a *L3n4Addr) IsAmbiguous(b *L3n4Addr) bool {
+	if !a.IP.Equal(b.IP) || a.Port != b.Port || a.Protocol == b.Protocol {
+		return false
+	}
+	return a.Protocol == NONE || b.Protocol == NONE
+}
+
 // IsIPv6 returns true if the IP address in the given L3n4Addr is IPv6 or not.
 func (a *L3n4Addr) IsIPv6() bool {
 	return a.IP.To4() == nil
@@ -0,0 +1,94 @@
+// Copyright 2016-2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"net"
+	"testing"
+
+	"github.com/cilium/cilium/api/v1/models"
+
+	. "gopkg.in/check.v1"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type TypesSuite struct{}
+
+var _ = Suite(&TypesSuite{})
+
+func (s *TypesSuite) TestNewL4Type(c *C) {
+	for name, want := range map[string]L4Type{
+		"tcp":  TCP,
+		"UDP":  UDP,
+		"sctp": SCTP,
+		"any":  NONE,
+	} {
+		l4Type, err := NewL4Type(name)
+		c.Assert(err, IsNil)
+		c.Assert(l4Type, Equals, want)
+	}
+
+	_, err := NewL4Type("icmp")
+	c.Assert(err, Not(IsNil))
+}
+
+func (s *TypesSuite) TestModelProtocol(c *C) {
+	c.Assert(TCP.ModelProtocol(), Equals, models.FrontendAddressProtocolTCP)
+	c.Assert(UDP.ModelProtocol(), Equals, models.FrontendAddressProtocolUDP)
+	c.Assert(SCTP.ModelProtocol(), Equals, models.FrontendAddressProtocolSCTP)
+	c.Assert(NONE.ModelProtocol(), Equals, models.FrontendAddressProtocolAny)
+}
+
+func (s *TypesSuite) TestL3n4AddrProtocol(c *C) {
+	tcp, err := NewL3n4Addr(TCP, net.ParseIP("10.0.0.1"), 80)
+	c.Assert(err, IsNil)
+	udp, err := NewL3n4Addr(UDP, net.ParseIP("10.0.0.1"), 80)
+	c.Assert(err, IsNil)
+	any, err := NewL3n4Addr(NONE, net.ParseIP("10.0.0.1"), 80)
+	c.Assert(err, IsNil)
+	other, err := NewL3n4Addr(NONE, net.ParseIP("10.0.0.1"), 81)
+	c.Assert(err, IsNil)
+
+	c.Assert(tcp.String(), Equals, "10.0.0.1:80/TCP")
+	c.Assert(any.String(), Equals, "10.0.0.1:80")
+	c.Assert(tcp.SHA256Sum(), Not(Equals), udp.SHA256Sum())
+
+	c.Assert(tcp.IsAmbiguous(udp), Equals, false)
+	c.Assert(tcp.IsAmbiguous(tcp), Equals, false)
+	c.Assert(tcp.IsAmbiguous(any), Equals, true)
+	c.Assert(any.IsAmbiguous(udp), Equals, true)
+	c.Assert(tcp.IsAmbiguous(other), Equals, false)
+}
+
+func (s *TypesSuite) TestFindAmbiguous(c *C) {
+	tcp, _ := NewL3n4AddrID(TCP, net.ParseIP("f00d::1"), 80, 1)
+	udp, _ := NewL3n4AddrID(UDP, net.ParseIP("f00d::1"), 80, 2)
+	any, _ := NewL3n4AddrID(NONE, net.ParseIP("f00d::1"), 80, 3)
+
+	svcs := SVCMap{
+		tcp.SHA256Sum(): LBSVC{FE: *tcp, Sha256: tcp.SHA256Sum()},
+	}
+
+	c.Assert(svcs.FindAmbiguous(*udp), IsNil)
+	c.Assert(svcs.FindAmbiguous(*any).FE.ID, Equals, ServiceID(1))
+
+	// Replacing a service with the same ID is not ambiguous
+	any.ID = 1
+	c.Assert(svcs.FindAmbiguous(*any), IsNil)
+}
@@ -286,8 +286,8 @@ function write_cilium_cfg() {
         if [ $((node_index)) -eq 1 ]; then
             ubuntu_1404_interface="-d eth2"
             ubuntu_1604_interface="-d enp0s9"
-            ubuntu_1404_cilium_lb="--lb eth2"
-            ubuntu_1604_cilium_lb="--lb enp0s9"
+            ubuntu_1404_cilium_lb="--lb-interface eth2"
+            ubuntu_1604_cilium_lb="--lb-interface enp0s9"
         else
             ubuntu_1404_interface="-d eth1"
             ubuntu_1604_interface="-d enp0s8"
@@ -36,6 +36,7 @@
 // ../bpf/lib/drop.h
 // ../bpf/lib/eth.h
 // ../bpf/lib/events.h
+// ../bpf/lib/flow.h
 // ../bpf/lib/geneve.h
 // ../bpf/lib/icmp6.h
 // ../bpf/lib/ipv4.h
@@ -45,6 +46,7 @@
 // ../bpf/lib/lb.h
 // ../bpf/lib/lxc.h
 // ../bpf/lib/maps.h
+// ../bpf/lib/mirror.h
 // ../bpf/lib/nat46.h
 // ../bpf/lib/policy.h
 // ../bpf/lib/utils.h
@@ -143,7 +145,7 @@ func bpfCopying() (*asset, error) {
 	return a, nil
 }
 
-var _bpfBpf_lbC = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x57\x5d\x6f\xdb\x3a\xd2\xbe\x96\x7e\xc5\xbc\x29\x50\xd8\xa9\x9d\x8f\xd6\x6f\x76\x51\x1f\x07\x70\x1c\xa7\x35\xea\xda\x86\xed\xb4\xe8\x15\x41\x89\x23\x8b\xb0\x4c\x0a\x24\x65\xd7\xdb\xed\x7f\x5f\x90\x92\x62\xf9\x23\x39\x7b\xd0\x05\xba\x17\x1b\xe0\x9c\x26\x43\x72\xe6\x99\x99\x67\x1e\x52\x97\xe7\x3e\x9c\x03\xf4\x64\xba\x55\x7c\x11\x1b\xa8\xf5\xea\xf0\xf6\xea\xfa\xa6\xf9\xf6\xea\xfa\x6f\xd0\xcd\x4c\x2c\x95\x06\x19\x41\x8f\x27\x3c\x5b\xf9\x90\x1f\x98\xc7\x5c\x43\xaa\xe4\x42\xd1\x15\x70\x0d\x91\x42\x04\x2d\x23\xb3\xa1\x0a\xdb\xb0\x95\x19\x84\x54\x80\x42\xc6\xb5\x51\x3c\xc8\x0c\x02\x37\x40\x05\xbb\x94\x0a\x56\x92\xf1\x68\xeb\x1c\x71\x03\x99\x60\xa8\xc0\xc4\x08\x06\xd5\xca\x05\xb3\x7f\x7c\x18\x3d\xc2\x07\x14\xa8\x68\x02\x93\x2c\x48\x78\x08\x43\x1e\xa2\xd0\x08\x54\x43\x6a\x2d\x3a\x46\x06\x41\xee\xc8\x1e\x79\xb0\x28\x66\x05\x0a\x78\x90\x99\x60\xd4\x70\x29\xda\x80\xdc\xc4\xa8\x60\x8d\x4a\x73\x29\xe0\x6d\x19\xa4\xf0\xd8\x00\xa9\x9c\x97\x1a\x35\x16\xbc\x02\x99\xda\x83\x75\xa0\x62\x0b\x09\x35\xbb\xb3\x17\xcf\x95\x60\x97\x29\x03\x2e\x9c\xf7\x58\xa6\x08\x26\xa6\xc6\xa6\xb9\xe1\x49\x02\x01\x42\xa6\x31\xca\x92\x86\xf3\x11\x64\x06\xbe\x0e\xe6\x1f\xc7\x8f\x73\xe8\x8e\xbe\xc1\xd7\xee\x74\xda\x1d\xcd\xbf\xb5\x61\xc3\x4d\x2c\x33\x03\xb8\xc6\xdc\x17\x5f\xa5\x09\x47\x06\x1b\xaa\x14\x15\x66\x0b\x32\x72\x2e\x3e\xf7\xa7\xbd\x8f\xdd\xd1\xbc\x7b\x37\x18\x0e\xe6\xdf\x40\x2a\x78\x18\xcc\x47\xfd\xd9\x0c\x1e\xc6\x53\xe8\xc2\xa4\x3b\x9d\x0f\x7a\x8f\xc3\xee\x14\x26\x8f\xd3\xc9\x78\xd6\xbf\x00\x98\xa1\x05\x86\xce\xc3\x0b\x85\x8e\x5c\xb3\x14\x02\x43\x43\x79\xa2\x9f\x92\xff\x26\x33\xd0\xb1\xcc\x12\x06\x31\x5d\x23\x28\x0c\x91\xaf\x91\x01\x85\x50\xa6\xdb\x3f\xef\xa1\xf3\x42\x13\x29\x16\x2e\x55\x30\x95\x6a\xb6\x81\x47\x20\xa4\x69\xc0\x46\x71\x83\x60\xe4\x71\x77\xdd\xf9\x5d\x87\x1b\x30\x10\xe1\x45\x03\xfe\xff\x1a\x1e\x14\x15\xcb\x84\x0b\x98\x99\x06\x3c\xf0\xc8\xc4\xf0\x90\x48\xa9\x1a\x70\x27\xb5\xb1\x5b\x3f\x77\x01\xae\xde\x5e\x5f\x5f\x35\xaf\xdf\x5d\x5d\x03\x3c\xce\xba\x3e\x9c\x5f\xfa\xfe\xe5\xb9\xcb\xee\x1e\x75\xa8\xb8\x23\xc0\x7b\x98\x19\x2a\x98\x05\x8a\x90\x48\xca\x02\x9a\x50\x11\x3a\xbe\x52\xe3\x38\x1e\x20\x50\x63\x68\x68\xb9\x68\xa4\x25\x8c\xc3\x56\xfd\x11\x68\x08\xc3\x35\x0f\xf1\x02\xbe\x5a\x1a\xa4\xa8\x22\xa9\x56\x40\x61\x45\x53\x48\xa4\x5c\x66\x29\xc8\xbc\xd1\x0c\xb5\xe1\xc2\x65\x75\xe4\x68\x30\xb1\x23\x54\x90\x93\x26\xd5\xbd\x90\x4a\x65\x5c\xc3\x70\x8d\x6a\x0b\x83\xc9\xba\x65\x37\x9f\xf0\xb1\xbe\x81\x94\x86\x4b\x34\xae\x6d\x6b\x8e\xec\x02\x06\x91\x03\x63\xc2\x98\x8b\x05\xa0\x30\x6a\xeb\xe6\xda\x56\xb8\xf1\x44\x95\xea\x4f\x35\x36\x65\x4c\xa1\xd6\x4f\x1c\xb7\x7d\x33\x96\xb8\x12\x6c\xe1\x72\x36\x1c\x79\x08\xa5\x88\xf8\x22\x53\xa8\x41\x27\x74\x8d\xfa\x02\xc6\x45\x66\xc9\xf6\xb0\x16\x79\x7e\x79\xc1\x8f\x3c\xad\x68\x9a\x16\xe5\xcf\x5d\x81\x4e\x31\xe4\x11\x0f\xf3\x63\x54\xc3\x06\x93\xe4\x02\xe6\x31\x96\xb9\x73\x7d\xe4\xc6\xc4\x28\x20\xa5\x5a\x5b\x59\xa1\xe1\xb2\x64\x9e\x36\x34\x5c\x96\xdc\xef\x15\xa8\x1d\xaa\xf7\xce\x47\x13\x86\x77\xe4\x7e\x30\xeb\xde\x0d\xfb\x64\x30\xf9\xd2\x82\x26\x0c\x16\xc2\x0e\x8e\x6b\x43\x1e\x51\x9f\xdc\x7b\xb3\xb7\xf7\xe6\x78\xef\xb4\x7f\x3f\x98\xf6\x7b\x73\x87\xb0\x09\x53\x64\x5c\x61\x68\x72\xae\x01\x8f\xb8\x60\xf8\x7d\xb7\x7d\xd8\xaa\x24\xd4\x84\xbe\xa0\x41\x82\x30\x6c\xed\x9a\x6b\x19\x64\x0b\xc6\xc5\x22\xa7\xfd\x2b\x86\x11\x17\x08\x25\xaa\xe1\x78\x3c\xb9\xeb\xf6\x3e\x91\xe1\x9d\xef\xbf\xe2\x22\x4c\x32\x86\xf0\x87\x90\x0c\x49\xde\xb3\x8b\xf8\xb6\xba\x80\x86\xe1\xba\xb2\x54\x59\x0b\xd2\xe8\x92\xa6\xfc\xc0\xaa\x0d\xe3\xc2\xec\x7b\xb1\x36\xb9\xbf\xef\x2c\xe1\xc1\x65\x66\xac\xf0\xc4\x67\x07\xe6\x50\xae\x56\x52\x1c\xdb\x57\x34\x3d\xb1\x9b\xa7\xeb\x9b\x93\xd6\xd6\xb1\x35\x39\x61\x43\x13\x1f\x1b\x59\xb0\x38\x61\x54\x32\x3d\xe1\x33\xb0\x36\xff\x15\x8f\x04\xc3\xe8\x90\x02\xbe\x36\xd4\xf0\x10\xb8\x48\x6c\x1f\xb8\x30\x10\x53\xc1\x12\x24\x16\x77\x4d\x1b\x95\x85\x06\x08\xd1\x4b\x12\x64\x51\x04\xe7\x7a\x19\xd4\xfd\x1f\xbe\xb7\x96\x9c\xc1\x39\xa3\x86\x42\x07\x6a\xf9\x5f\x75\xa8\x59\x59\xad\x83\x5e\x06\xcd\x5b\xbb\xd6\xae\x6e\x24\x28\xd8\x4b\x9b\xed\x7a\xdb\xf7\x8a\x98\x49\x70\x43\x96\xb8\x05\xfb\x5f\x07\x7e\xfc\xdc\x5f\xd1\xa8\xac\xa8\xc1\xb9\x5e\x87\xbb\x15\x8b\x39\x66\x0a\xce\x79\x7a\x03\x1d\x70\xe8\xde\x40\x7f\xfe\x91\x7c\x1c\xf6\x47\x6d\xdf\xcb\x84\x9d\xe7\xf5\x8d\x55\x0d\x38\x67\xda\x58\x3c\xfb\xc6\x3a\xbc\xe6\xe9\x8d\x45\xc4\x98\xda\xb9\x0e\x75\xb6\x22\x32\x8a\x34\xee\x7e\x2f\x71\xd9\xaa\x25\xef\xac\xa5\x01\x49\x2b\xff\x57\xa1\x39\x8c\x27\x70\x43\x98\xb6\x66\x42\xb2\xbf\x83\xc0\xef\x26\x76\x21\x08\xc9\xae\x6f\x72\xf5\x68\xfb\xbe\xc7\x23\xa8\x1d\x40\x87\x37\xa0\xf9\x3f\x50\x46\x35\x9b\x5a\x1d\x6e\xa1\x2c\x58\xdd\xf7\x3c\x85\x26\x53\x02\xee\xa7\xe3\x09\x19\x8c\xbe\x74\x87\x83\x7b\xeb\x27\x74\xef\x26\x62\x14\x0d\x91\x84\x34\x35\x99\xc2\x9a\x5e\x06\x0d\xb8\xbf\xfb\x40\x7a\xdd\xc9\xfc\x71\xda\x27\x0f\xd3\xf1\x67\x32\xbc\x6b\xe4\x7d\xe0\x62\x61\xe5\x94\x14\xc3\x5d\xb7\x7e\x0a\xa0\xd0\x01\x57\x98\x1d\x6e\x5b\x6e\x62\x53\x23\xf6\xe6\xad\xbd\x5e\xe2\xf6\xa2\xd0\xe3\x06\x30\x6d\xea\x6d\xdf\xcb\xeb\x02\x9d\x6a\x17\xf2\x1a\x55\x6c\xf0\xc6\xb5\x8e\xc4\x4c\x25\x28\x72\x8c\xe5\x5a\x03\x5e\x17\x11\xad\x3b\x57\xfa\xfc\xbc\x46\x43\xa8\x60\x24\x4a\xe8\x42\xd7\x8a\x3d\x0d\x78\x5d\x76\xc7\x62\x7f\xc5\xa3\x82\xf4\xc3\x96\x6f\xeb\x04\x1d\xc0\xef\xb6\x22\xc6\x7a\xb1\x0a\x9d\x47\x7b\x3a\x5e\xf6\xcf\x25\xc3\xec\x06\x1b\xd6\xb6\x64\x30\x23\xfd\xe9\xb4\xa6\xd0\xd4\xeb\xf0\xc3\xf7\x9c\xd1\xb9\xec\xe4\xa5\x7f\x1c\x7d\x1a\x8d\xbf\x8e\xc8\xb0\x95\xaf\x7b\x97\xe7\x30\xa1\x5a\x43\x26\x96\x42\x6e\x84\x95\x42\x23\x73\x49\xb7\xea\xe7\x3d\x75\x6e\xde\x23\xdd\xde\x9c\x8c\x3f\xb5\x7d\xcf\xfb\x09\x98\x68\xac\xac\xe6\x54\xfa\xe9\xbf\x42\xc1\x78\xe4\xfb\x9e\x5e\x87\xd0\x71\x63\x90\xdf\xdf\xe5\x34\xe4\xa9\x58\xe4\x25\x66\xb7\xb3\x03\xa3\xc7\xe1\x30\xc7\x54\x42\x2a\xb4\x7e\xef\x9a\x81\x4d\xcc\xc3\xb8\x7c\x5c\x09\x69\xec\x7d\x5a\x79\x79\xb0\x1c\xf4\x09\xcc\x3f\x2d\x28\x77\xf9\x75\x8a\xe9\x4c\x30\x34\xc4\x99\x76\xa0\x1a\xa0\xd7\x61\xf3\x36\x94\x99\x30\xc5\xef\x1b\xb4\x4f\xff\x12\xed\xff\xd5\x8e\x33\x3b\x72\x61\x0d\xf5\xfa\x21\xed\x47\x63\x32\xeb\x4f\xbf\x0c\x7a\x7d\x37\x41\x07\xc4\x2c\x26\xaf\x01\xaf\x5d\x54\x43\xd5\x02\x4d\xa5\x46\xcd\x5b\x85\x6b\x22\xa8\x21\x39\xeb\x7d\xcf\x2b\x8e\x5c\xa4\x2d\xf8\x67\x07\x8e\xf7\xd8\x30\x39\x9f\x2c\xda\xef\xf6\xa1\x5e\xc0\x7c\x0a\xb6\xe3\xd4\x81\x36\x3c\x51\xb4\x52\x97\x53\x2c\xf3\xf7\x29\xe0\x1f\x94\xbe\xbc\x9e\xdb\xfe\x8e\x1b\xa7\x65\xbe\xf5\xa2\xcc\xb7\x7e\xaf\xcc\xb7\x9e\x95\xf9\xd6\xb3\x32\x5f\x88\xfc\x49\x8d\xff\x25\xc1\x26\x24\xc0\x77\x6f\xff\x63\x52\xfd\x1b\x95\xba\x79\x9b\x2a\x69\x64\x28\x93\xb6\xef\x55\x94\xb9\x58\x2c\xaf\xb7\xbf\xa0\xcf\xad\x52\x9f\x79\xfa\x3f\x29\x3e\x90\xe2\xd6\x7f\xa7\x14\xb7\x7e\x5d\x8a\x5b\xbf\x26\xc5\xc5\x24\x41\xa1\xa1\xb9\xf4\xb6\x77\xda\xd9\x3a\xad\x9d\xb6\x4c\xe5\xff\x7f\x87\x8e\x12\xa2\x31\xb4\x9f\x59\xb5\xb3\x48\xc9\x55\x33\xff\xd0\x38\xab\xfb\x56\x3a\xac\x85\xe4\x96\x17\xa4\xd3\xee\x2c\xe2\x05\x69\x44\xc2\x04\xa9\x22\x61\x60\x33\x75\xc3\xaa\x37\xdc\x84\x31\xd4\xdc\x34\x97\xb3\x6a\xa9\xf1\xdc\x53\xdd\x0b\xa9\x46\xb0\xbe\x62\x23\x85\xae\xd9\xe9\x9c\xb8\xa5\xfa\xfb\x3c\x45\xe8\xec\xbf\xde\x5d\x24\xcf\x0b\x14\xd2\x65\xfb\x4f\xef\x88\xe7\xfc\x9f\xf4\xde\x7a\xce\xbb\xc7\x30\xa2\x59\x62\xde\xfb\xc7\x53\x67\x14\x8d\xec\x97\xf1\x1e\xd5\x5f\x62\xf2\xf3\x9d\xd4\x28\x18\xb1\x5f\x3c\x44\x48\xc3\xa3\x2d\x41\xa5\xa4\xca\x69\xa4\xd0\x34\x4a\x57\xb3\x8f\xe3\xf9\xbe\xfc\x94\xed\xf6\xab\xaa\x71\x40\x85\x42\x55\x84\x29\xbf\x72\xa1\x53\x3d\xda\xae\xb8\xbb\x9f\xcd\x3f\x77\x7b\xbe\x57\xbc\xf5\x57\x34\x74\x8f\xfd\x15\x0d\xf3\x33\xf9\xba\x6d\xb8\x8b\x87\x26\x26\xda\x48\x85\xc4\x29\x70\x0e\xb8\xe6\x6e\x19\xfb\xe9\xb1\xa2\xa1\x13\xea\x06\x5c\xd5\xe1\x0f\xb8\xaa\x17\xe2\x03\x85\xb0\x7d\x9d\x0e\xe6\x7d\x5b\x8f\xf1\xf4\xa9\xe4\xde\xbf\x79\x7f\xdc\xf7\x87\x83\x2f\xfd\xe9\xb7\x06\xec\xee\x8c\xca\x64\xe4\x1f\xf7\xb5\x62\xcd\x02\xa8\x4a\xdd\x5f\x8e\xe1\x8e\x1f\xb7\xf5\xa7\xef\xdf\x4d\x1e\xc8\x70\xd0\xeb\x8f\x66\xfd\xda\xd9\x87\xc9\xf0\xac\xde\xf6\xff\x15\x00\x00\xff\xff\xaa\x4f\xd1\x1f\x0e\x16\x00\x00")
+var _bpfBpf_lbC = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x58\x6d\x6f\xdb\x3a\xd2\xfd\x2c\xfd\x8a\x79\x52\x20\xb0\x73\x9d\xb7\x5e\x3f\xd9\x45\x7d\x5d\xc0\x71\x9c\xd6\xa8\x6b\x1b\xb6\xd3\xa2\x9f\x08\x4a\x1c\x45\x84\x65\x52\x20\x29\xa7\xde\x6e\xff\xfb\x62\x28\x29\x76\x62\xa7\xf7\xde\x7d\x41\xf7\xc3\xb6\x68\x63\x0f\x87\x87\x87\x33\x87\x87\x52\xce\x4f\x42\x38\x01\xe8\xeb\x7c\x63\xe4\x7d\xea\xa0\xd1\x6f\xc2\xeb\x8b\xcb\xab\xd3\xd7\x17\x97\x7f\x81\x5e\xe1\x52\x6d\x2c\xe8\x04\xfa\x32\x93\xc5\x2a\x84\x72\xc2\x22\x95\x16\x72\xa3\xef\x0d\x5f\x81\xb4\x90\x18\x44\xb0\x3a\x71\x0f\xdc\x60\x07\x36\xba\x80\x98\x2b\x30\x28\xa4\x75\x46\x46\x85\x43\x90\x0e\xb8\x12\xe7\xda\xc0\x4a\x0b\x99\x6c\x3c\x90\x74\x50\x28\x81\x06\x5c\x8a\xe0\xd0\xac\xfc\x62\xf4\xe5\xdd\xf8\x0e\xde\xa1\x42\xc3\x33\x98\x16\x51\x26\x63\x18\xc9\x18\x95\x45\xe0\x16\x72\x8a\xd8\x14\x05\x44\x25\x10\x4d\xb9\x25\x16\xf3\x8a\x05\xdc\xea\x42\x09\xee\xa4\x56\x1d\x40\xe9\x52\x34\xb0\x46\x63\xa5\x56\xf0\xba\x5e\xa4\x42\x6c\x81\x36\x1e\xa5\xc1\x1d\x91\x37\xa0\x73\x9a\xd8\x04\xae\x36\x90\x71\xb7\x9d\x7b\xf6\x52\x09\xb6\x3b\x15\x20\x95\xdf\x4f\xaa\x73\x04\x97\x72\x47\x7b\x7f\x90\x59\x06\x11\x42\x61\x31\x29\xb2\x96\x5f\x2e\x2a\x1c\x7c\x1e\x2e\xde\x4f\xee\x16\xd0\x1b\x7f\x81\xcf\xbd\xd9\xac\x37\x5e\x7c\xe9\xc0\x83\x74\xa9\x2e\x1c\xe0\x1a\x4b\x2c\xb9\xca\x33\x89\x02\x1e\xb8\x31\x5c\xb9\x0d\xe8\xc4\x43\x7c\x1c\xcc\xfa\xef\x7b\xe3\x45\xef\x7a\x38\x1a\x2e\xbe\x80\x36\x70\x3b\x5c\x8c\x07\xf3\x39\xdc\x4e\x66\xd0\x83\x69\x6f\xb6\x18\xf6\xef\x46\xbd\x19\x4c\xef\x66\xd3\xc9\x7c\x70\x06\x30\x47\x22\x86\x1e\xe1\x07\x85\x4e\x7c\xb3\x0c\x82\x40\xc7\x65\x66\x1f\x37\xff\x45\x17\x60\x53\x5d\x64\x02\x52\xbe\x46\x30\x18\xa3\x5c\xa3\x00\x0e\xb1\xce\x37\xbf\xdf\x43\x8f\xc2\x33\xad\xee\xfd\x56\xc1\xed\x54\xb3\x03\x32\x01\xa5\x5d\x0b\x1e\x8c\x74\x08\x4e\xef\x77\xd7\xcf\xdf\x76\xb8\x05\x43\x15\x9f\xb5\xe0\xff\x2f\xe1\xd6\x70\xb5\xcc\xa4\x82\xb9\x6b\xc1\xad\x4c\x5c\x0a\xb7\x99\xd6\xa6\x05\xd7\xda\x3a\x4a\xfd\xd8\x03\xb8\x78\x7d\x79\x79\x71\x7a\xf9\xeb\xc5\x25\xc0\xdd\xbc\x17\xc2\xc9\x79\x18\x9e\x9f\xf8\xd6\xde\xa0\x8d\x8d\xf4\x02\x78\x03\x73\xc7\x95\x20\xa2\x08\x99\xe6\x22\xe2\x19\x57\xb1\xd7\x2b\x77\x5e\xe3\x11\x02\x77\x8e\xc7\xa4\x45\xa7\x49\x30\x84\xf1\xe4\x8f\x42\xc7\x04\xae\x65\x8c\x67\xf0\x99\x64\x90\xa3\x49\xb4\x59\x01\x87\x15\xcf\x21\xd3\x7a\x59\xe4\xa0\xcb\x46\x0b\xb4\x4e\x2a\xaf\xdb\x3d\xa0\xe1\x94\x8e\x50\x25\x4e\x9e\xed\xe6\x42\xae\x8d\xf3\x0d\xc3\x35\x9a\x0d\x0c\xa7\xeb\x36\x25\x1f\xc0\x58\x5f\x41\xce\xe3\x25\x3a\xdf\xb6\xb5\x44\x71\x06\xc3\xc4\x93\x71\x71\x2a\xd5\x3d\xa0\x72\x66\x43\xa2\x4e\xa8\xc2\xad\x47\xa9\xec\xfe\xdd\x5d\x9b\x0b\x61\xd0\xda\x47\x8d\x53\xdf\x1c\x09\x57\x03\x15\x4e\x27\x07\x11\x62\xad\x12\x79\x5f\x18\xb4\x60\x33\xbe\x46\x7b\x06\x93\x6a\x67\xd9\xe6\x79\x2d\xca\xfd\x95\x05\xdf\x43\x5a\xf1\x3c\xaf\xca\x5f\x42\x81\xcd\x31\x96\x89\x8c\xcb\x69\xdc\xc2\x03\x66\xd9\x19\x2c\x52\xac\xf7\x2e\xed\x1e\x8c\x4b\x51\x41\xce\xad\x25\x5b\xe1\xf1\xb2\x56\x9e\x75\x3c\x5e\xd6\xda\xef\x57\xac\xfd\xce\xdf\x50\x04\x4e\x61\x74\xcd\x6e\x86\xf3\xde\xf5\x68\xc0\x86\xd3\x4f\x6d\x38\x85\xe1\xbd\xd2\x06\xa9\xda\xed\x6a\x45\x7b\x30\xf7\xea\x49\xee\xd5\x7e\xee\x6c\x70\x33\x9c\x0d\xfa\x0b\xcf\xf0\x14\x66\x28\xa4\xc1\xd8\x11\x37\xae\x40\x26\x52\x09\xfc\xba\x4d\x1f\xb5\xeb\xdd\xf8\xf4\x81\xe2\x51\x86\x30\x6a\x6f\x9b\xcb\x95\x20\xd9\xe5\x52\xdd\x97\xb2\x7f\x25\x30\x91\x0a\xa1\x66\x35\x9a\x4c\xa6\xd7\xbd\xfe\x07\x36\xba\x0e\xc3\x57\x52\xc5\x59\x21\x10\x7e\x53\x5a\x20\x2b\x7b\x76\x96\xbe\xdd\x1d\x40\x27\x70\xbd\x33\xb4\x33\x16\xe5\xc9\x39\xcf\xe5\xb3\xa8\x75\x42\x2a\xf7\x14\x85\x62\xfa\x69\xde\x51\x26\xa3\xf3\xc2\x91\xf1\xa4\x47\xcf\xc2\xb1\x5e\xad\xb4\xda\x8f\xaf\x78\x7e\x20\x5b\xe6\xeb\xab\x83\xd1\xf6\x7e\x34\x3b\x10\x43\x97\xee\x07\x45\x74\x7f\x20\x68\x74\xbe\x1f\xcd\x22\x8a\x85\xaf\x64\xa2\x04\x26\xcf\x25\x10\x5a\xc7\x9d\x8c\x41\xaa\x8c\xfa\x20\x95\x83\x94\x2b\x91\x21\x23\xde\x0d\xeb\x4c\x11\x3b\x60\xcc\x2e\x59\x54\x24\x09\x9c\xd8\x65\xd4\x0c\xbf\x85\xc1\x5a\x4b\x01\x27\x82\x3b\x0e\x5d\x68\x94\xdf\x9a\xd0\x20\x5b\x6d\x82\x5d\x46\xa7\x6f\x69\xac\xb3\x9b\xc8\x50\x89\x1f\x25\xd3\x78\x27\x0c\xaa\x35\xb3\xe8\x8a\x2d\x71\x03\xf4\xaf\x0b\xdf\xbe\x3f\x1d\xb1\x68\xc8\xd4\xe0\xc4\xae\xe3\xed\x08\x71\x4e\x85\x81\x13\x99\x5f\x41\x17\x08\x14\x7e\x81\xc1\xe2\x3d\x7b\x3f\x1a\x8c\x3b\x61\x50\x28\xf2\x8c\xf5\x15\xb9\x06\x9c\x08\xeb\x88\xcf\xd3\x60\x13\x8e\x65\x7e\x45\x8c\x84\x30\x5b\xe8\xd8\x16\x2b\xa6\x93\xc4\xe2\xf6\x73\xcd\x8b\xaa\x96\xfd\x4a\x91\x16\x64\xed\xf2\xa7\x41\xf7\x7c\x3d\x85\x0f\x4c\x58\x0a\x33\x56\xfc\x15\x14\x7e\x75\xa9\x5f\x82\xb1\xe2\xf2\xaa\x74\x8f\x4e\x18\x06\x32\x81\xc6\x33\xea\xf0\x0b\x58\xf9\x37\xd4\x49\x83\xb6\xd6\x84\xb7\x50\x17\xac\x19\x06\x81\x41\x57\x18\x05\x37\xb3\xc9\x94\x0d\xc7\x9f\x7a\xa3\xe1\x0d\xe1\xc4\xfe\xb9\x89\x39\xc3\x63\x64\x31\xcf\x5d\x61\xb0\x61\x97\x51\x0b\x6e\xae\xdf\xb1\x7e\x6f\xba\xb8\x9b\x0d\xd8\xed\x6c\xf2\x91\x8d\xae\x5b\x65\x1f\xa4\xba\x27\x3b\x65\xd5\xe1\x6e\x12\x4e\x45\x14\xba\xe0\x0b\xb3\xe5\x4d\xe5\x66\x54\x35\x46\x37\x6f\xe3\x78\x89\x9b\xb3\xca\x8f\x5b\x20\xac\x6b\x76\xc2\xa0\xac\x0b\x74\x77\xbb\x90\xb5\x9f\xc5\xe0\x17\xf0\x58\xa9\x30\x19\xaa\x92\x63\x3d\xd6\x82\xe3\x6a\x45\x82\xf3\xa5\x2f\xe7\x5b\x74\x8c\x2b\xc1\x92\x8c\xdf\xdb\x46\x95\xd3\x82\xe3\xba\x3b\xc4\xfd\x95\x4c\x2a\xd1\x8f\xda\x61\x40\xfc\x72\xa3\x9d\x86\xee\x4e\xf5\x0d\x92\x0a\xf0\x2b\xd5\xc9\xb1\xac\xcd\xc8\xb7\x4b\x0e\x8f\xa0\x75\x57\xfd\x16\x05\x25\x10\x19\x6a\xd4\x70\xce\x06\xb3\x59\xc3\xa0\x6b\x36\xe1\x5b\x18\xf8\xee\x79\xc8\x6e\xd9\x90\xbb\xf1\x87\xf1\xe4\xf3\x98\x8d\xda\xe5\x78\x70\x7e\x02\x53\x6e\x2d\x14\x6a\xa9\xf4\x83\x22\x83\x74\xba\x34\x7a\xf2\xc4\xe0\xb1\x9f\x8b\x3e\xeb\xf5\x17\x6c\xf2\xa1\x13\x06\xc1\x77\xc0\xcc\xe2\xce\x68\x29\xb0\xef\xe1\x2b\x54\x42\x26\x61\x18\xd8\x75\x0c\x5d\x7f\x38\xca\x5b\xbd\x3e\x23\xe5\x56\x88\x79\xcd\xd9\x67\x76\x61\x7c\x37\x1a\x95\x9c\x6a\x4a\xd5\x0d\xf0\xe4\xf2\x81\x87\x54\xc6\x69\xfd\xc8\xa5\xb4\x83\xe8\xc9\xf3\x88\x28\x49\x1f\xe0\xfc\x9d\x48\x91\xa8\x2b\x5a\x16\x33\x8c\x1d\xf3\x3a\xdf\x92\x6a\x81\x5d\xc7\xa7\x6f\x63\x5d\x28\x57\x7d\x7e\x40\x7a\x21\xa8\xd9\xfe\x5f\x63\x6f\x67\x3c\x76\x72\x8d\x07\x90\x28\xb0\xd7\xb6\x66\xf3\xf9\x21\x19\x4f\xd8\x7c\x30\xfb\x34\xec\x0f\x3a\xe1\xbe\x8c\xab\x73\xda\x82\x63\xcf\xc6\x71\x73\x8f\x8f\x6c\x7c\xc8\xe0\x9a\x29\xee\x58\x79\x46\xc2\x20\xa8\xa6\x9c\xe5\x6d\xf8\x7b\x17\xf6\x73\x68\x19\x2f\x0a\x5f\x88\xaf\xf4\x58\x5f\xf1\x7e\x5c\x6c\x4b\xfa\x99\x93\x3c\x0a\x7a\xa7\x5e\x87\xd4\xb7\xdd\xa3\x97\x46\xf8\xac\x25\xf5\x65\xde\x09\xb7\x9a\x39\x7c\x29\xb4\x7f\x78\x29\xb4\x7f\xee\xa5\xd0\x7e\xf1\x52\x68\xbf\x78\x29\x54\x57\xc2\xc1\x1b\xa1\x4a\xfa\xe7\xec\x9d\xb1\x08\x7f\x7d\xfd\x6f\x33\xf6\x9f\xe8\xeb\xa7\x6f\xbd\x31\xc6\x3a\xeb\x84\xc1\x8e\x8f\x57\x83\xf5\x65\xf8\x27\xdc\xbc\x5d\xbb\xb9\xcc\xff\x67\xdc\x7f\xc8\xb8\xdb\xff\x9d\xc6\xdd\xfe\xd7\x8d\xbb\xfd\x9f\x31\xee\xea\xdc\x41\xe5\xb8\xa5\x51\x3f\x0a\x83\x98\x1f\x74\x5a\x2a\x5f\xfd\xff\xcf\x70\x5d\xc6\x2c\xc6\xf4\x0a\xd7\x38\x4a\x8c\x5e\x9d\x96\x2f\x31\x47\xcd\x90\x8c\x86\x22\xac\x8c\xfc\xc0\x68\x29\xb3\x5a\x2f\xca\x13\x16\x67\xc8\x0d\x8b\x23\x6a\x8e\x3f\xda\xf6\x41\xba\x38\x05\xfa\xbe\x3d\xd9\x24\x99\x97\x5e\x03\x82\x98\x5b\x04\xc2\x4a\x9d\x56\xb6\x41\xfe\x34\xf5\x43\xcd\x37\x65\x0f\xa0\xbb\x73\x09\x5c\x55\x2b\x05\x41\x64\x90\x2f\x3b\xbf\x7b\xa3\xbc\x84\x7f\x10\xbd\xfd\x12\x7a\x20\x30\xe1\x45\xe6\xde\x84\xfb\xa7\xd1\x19\x9e\xd0\x5b\xf7\x93\x23\xf0\x23\x85\xbf\xdc\x49\x8b\x4a\x30\x61\x74\xce\x94\x76\x32\xd9\x30\x34\x46\x1b\x22\xe5\x8d\xbf\x55\x43\xcd\xdf\x4f\x16\x4f\xcd\xaa\x6e\x77\xb8\xeb\x26\xcf\xa4\x50\xb9\x8d\x72\xf5\x1b\x34\x74\x77\xa7\x76\x76\xe0\x6e\xe6\x8b\x8f\xbd\x7e\x18\x54\xef\x11\x2b\x1e\x93\x0f\xc3\x8a\xd3\xf3\xd0\xe3\x38\x35\xdc\xaf\x87\x2e\x65\xd6\x69\x83\xcc\xfb\x75\x49\xb8\xe1\x5f\x36\x4e\x9a\x70\xbc\xe2\xb1\x7f\x3c\x6f\xc1\x45\x13\x7e\x83\x8b\x66\x65\x4a\x50\x19\xde\xe7\xd9\x70\x31\xa0\x7a\x4c\x66\x8f\x25\x0f\xfe\xe0\x6d\x73\x33\x18\x0d\x3f\x0d\x66\x5f\x5a\xf5\xa6\x9a\x9d\x6d\x3d\x4d\xf5\x8b\x83\x46\x35\x46\x04\x76\x2d\xf0\x4f\xaf\xe1\xa7\x57\xe0\x55\x75\xe9\x89\xf3\x7b\x18\x5e\x4f\x6f\xd9\x68\xd8\x1f\x8c\xe7\x83\xc6\xd1\xbb\xe9\xe8\xa8\xd9\x09\xff\x31\x00\x02\xa7\xf7\x41\x6a\x16\x00\x00")
 
 func bpfBpf_lbCBytes() ([]byte, error) {
 	return bindataRead(
@@ -158,12 +160,12 @@ func bpfBpf_lbC() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "bpf/bpf_lb.c", size: 5646, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
+	info := bindataFileInfo{name: "bpf/bpf_lb.c", size: 5738, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _bpfBpf_lxcC = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5c\x7b\x73\x1a\xb9\x96\xff\x1b\x3e\xc5\x99\x99\x2a\x16\x3c\x0c\xb6\x13\xae\xf7\x56\x3c\x4c\x15\xc1\x38\xa1\x82\x81\x02\x9c\x4c\x76\x2a\xa5\x6a\xba\x85\xd1\xba\x69\xf5\x4a\x6a\x3b\xbe\x77\xb2\x9f\x7d\xeb\xe8\xd1\x0f\x68\x30\x4e\x72\xe7\x71\x77\xb6\x6a\xef\xc4\x6a\xb5\xfa\xe8\x3c\x7f\xe7\x1c\x89\xe3\xa3\x2a\x1c\x01\xf4\x78\xfc\x20\xd8\xcd\x4a\x41\xbd\xd7\x80\x67\x27\xa7\x67\x3f\x3c\x3b\x39\xfd\x4f\xe8\x26\x6a\xc5\x85\x04\xbe\x84\x1e\x0b\x59\xb2\xae\x82\x79\x61\xbe\x62\x12\x62\xc1\x6f\x84\xb7\x06\x26\x61\x29\x28\x05\xc9\x97\xea\xde\x13\xf4\x1c\x1e\x78\x02\xbe\x17\x81\xa0\x01\x93\x4a\xb0\x45\xa2\x28\x30\x05\x5e\x14\x1c\x73\x01\x6b\x1e\xb0\xe5\x83\x5e\x88\x29\x48\xa2\x80\x0a\x50\x2b\x0a\x8a\x8a\xb5\xfe\x18\xfe\xf1\x6a\x74\x0d\xaf\x68\x44\x85\x17\xc2\x24\x59\x84\xcc\x87\x21\xf3\x69\x24\x29\x78\x12\x62\x1c\x91\x2b\x1a\xc0\xc2\x2c\x84\xaf\x5c\x22\x15\x33\x4b\x05\x5c\xf2\x24\x0a\x3c\xc5\x78\x74\x0e\x94\xa9\x15\x15\x70\x47\x85\x64\x3c\x82\x67\xee\x23\x76\xc5\x26\x70\xa1\x57\xa9\x7b\x0a\x89\x17\xc0\x63\x7c\xb1\x01\x5e\xf4\x00\xa1\xa7\xb2\x77\x5b\xbb\x58\x90\xed\x34\x00\x16\xe9\xd5\x57\x3c\xa6\xa0\x56\x9e\xc2\x6d\xde\xb3\x30\x84\x05\x85\x44\xd2\x65\x12\x36\xf5\x1a\x8b\x44\xc1\xbb\xc1\xfc\xf5\xf8\x7a\x0e\xdd\xd1\x7b\x78\xd7\x9d\x4e\xbb\xa3\xf9\xfb\x73\xb8\x67\x6a\xc5\x13\x05\xf4\x8e\x9a\xb5\xd8\x3a\x0e\x19\x0d\xe0\xde\x13\xc2\x8b\xd4\x03\xf0\xa5\x5e\xe2\xaa\x3f\xed\xbd\xee\x8e\xe6\xdd\x97\x83\xe1\x60\xfe\x1e\xb8\x80\xcb\xc1\x7c\xd4\x9f\xcd\xe0\x72\x3c\x85\x2e\x4c\xba\xd3\xf9\xa0\x77\x3d\xec\x4e\x61\x72\x3d\x9d\x8c\x67\xfd\x16\xc0\x8c\x22\x61\x54\xaf\xb0\x87\xd1\x4b\x2d\x2c\x41\x21\xa0\xca\x63\xa1\x4c\x37\xff\x9e\x27\x20\x57\x3c\x09\x03\x58\x79\x77\x14\x04\xf5\x29\xbb\xa3\x01\x78\xe0\xf3\xf8\xe1\x71\x19\xea\x55\xbc\x90\x47\x37\x7a\xab\xa0\x72\xdc\x3c\x07\xb6\x84\x88\xab\x26\xdc\x0b\xa6\x28\x28\xbe\x2d\x5d\xfd\x7e\x26\xe1\x26\x0c\x22\xbf\xd5\x84\xbf\x9d\xc2\xa5\xf0\xa2\xdb\x90\x45\x30\x53\x4d\xb8\x64\x4b\xb5\x82\xcb\x90\x73\xd1\x84\x97\x5c\x2a\x9c\x7a\xd5\x05\x38\x79\x76\x7a\x7a\xf2\xc3\xe9\xf3\x93\x53\x80\xeb\x59\xb7\x0a\x47\xc7\xd5\xef\x58\xe4\x87\x49\x40\xe1\xc7\x88\x07\x94\xf8\x3c\x5a\xb2\x9b\xd6\xea\xa7\xdc\x83\xf0\xa3\x9f\x1b\xaf\x7e\x17\xd0\x25\x8b\x28\xf4\xdf\xf6\x47\x73\x32\x1b\x5f\x4f\x7b\x7d\x18\xfe\xdc\x23\x83\x8b\x6a\xee\xad\x45\xbc\x3c\xf6\x62\x66\x5e\x49\x47\xa5\x0a\x58\xa4\x8a\xeb\xe3\x18\xdf\x98\x17\xb2\x28\xf9\x78\xcc\xfc\x75\x7c\x77\x56\x7c\xf4\x6d\xc8\x16\xc7\x89\x42\xc1\xac\xbe\xdd\x18\xf6\xf9\x7a\xcd\xa3\xed\xf1\xb5\x17\x97\xcc\xf6\x44\xbc\x3d\xc8\xf4\x07\x4b\x46\xdb\x25\xa3\xfe\x3a\x2e\x99\x4c\xd5\x6a\x7b\x30\x58\xdc\x6c\x0f\x86\xcf\x4b\xc6\x3e\xfa\xdb\x83\x91\xa7\xda\x25\x5f\x8a\x79\xc8\xfc\x87\x92\x35\x16\x25\x04\x08\x5e\xb2\xdd\x52\xb2\x7c\x99\xac\xcb\x98\x1b\x45\x4a\x78\xfe\x2d\x3e\x4a\xb5\x60\x32\x1e\x0e\x7a\xef\xc9\xe0\x02\xea\x75\xa3\x04\xf0\xe3\x8f\x70\x7a\xd6\x80\x5f\x61\xd6\xef\x0d\xbb\x2f\xfb\xc3\x46\xb5\x2a\x95\x48\x7c\x05\x8b\x78\x49\x68\xb8\x24\x6b\x2f\x06\x42\x24\xf5\x51\x8f\xf1\x2f\x09\xbd\x39\xb9\xea\x4e\xce\xa0\x03\xff\xac\x7e\xc7\x96\x01\x5d\xc2\xeb\xee\xdb\x3e\x19\x4e\xaf\xf1\x01\x99\xbf\x9f\xf4\xab\x95\x96\x7a\x88\x69\xa5\xd2\x81\x97\x93\xcb\x74\x58\xcf\x79\xdd\x9d\xbd\x6e\x56\xbf\xa3\xa1\xa4\xbb\xa6\xb9\x29\x51\xc0\x96\xd5\x4a\x4b\xb2\x7f\x50\x72\x4b\x1f\x2a\x1d\xc0\x7f\xf2\x65\xdd\x52\x89\x3a\x40\x7c\x45\x54\x12\x87\xb4\xd1\x74\x53\xef\xbc\x30\xa1\x5b\x93\x7d\x45\x68\xa4\xc4\x83\x9e\x17\xb3\x28\x62\xd1\x4d\xa5\x03\x93\xc1\x88\xbc\x1a\x8e\x5f\x76\x87\x64\x34\xc3\x47\x6b\xef\x23\xa1\x21\x5d\x57\x3a\x76\xab\x64\x36\xf8\xaf\x7e\xb3\xfa\xe9\xfc\x70\xee\xb4\xff\x20\xdc\x69\xff\xa6\xdc\xf9\x8e\x2d\xe1\x1b\xa3\x6e\x01\x5c\x0c\x66\xdd\x97\xc3\x3e\x99\x8c\xa7\x7a\x1e\xd4\x6a\xe0\x9e\xa1\xfe\xb9\xf1\xc9\x60\xf4\x6a\x56\x95\xca\x53\xcc\x07\x16\x85\xa8\xab\x2c\x52\xb0\xf6\x62\x82\x0e\x8d\x27\xca\xd1\x48\x88\xbc\x25\x8b\x64\xb9\x84\x23\x79\xbb\x68\xea\x69\x61\x9b\xf0\xe5\xb2\x09\x84\x24\x7f\x87\x88\x7e\x54\xab\x40\x34\xaa\xff\xac\x56\xdc\xbe\x64\xb2\xc6\x19\x92\x2a\xe0\xcb\x25\xca\xe5\xd3\x79\xb5\x92\xb0\x48\x9d\x9e\x11\x05\x32\xe6\x42\x9d\x57\x2b\xb8\x16\x6b\x82\xa0\xf8\x87\x7d\x17\x1f\xa1\x88\x43\xee\x7b\x21\x8a\xf7\x97\x0f\x5a\xae\x95\xca\xf6\x06\x2a\xc8\x80\xca\xf1\x11\x0c\x6e\x22\x8c\x49\x49\x74\x1b\xf1\xfb\x08\x86\x6d\x0c\x1c\x8a\xfb\x3c\x94\xe8\xc6\x2b\x6c\x09\x75\x4b\x27\x7c\xd3\x81\xc1\x64\x32\x1d\xcf\xc7\x64\xde\xd3\x1c\x2a\x79\x72\x7d\x31\x69\x54\x2b\x15\x41\x55\x22\x22\x38\xb1\x9f\x99\x70\xa1\x37\x24\xa9\x92\x3a\x12\xe2\x02\x5e\x14\xc0\xf5\xc5\x04\x10\x60\x60\x50\x92\xde\x9a\xa6\x1f\x95\xb7\x0b\x12\x72\x2f\x20\x8b\x07\x45\x65\x5d\x73\xd0\x70\x0f\xbe\xc7\xb7\xc9\x4c\xef\x68\x7c\x79\xd9\x84\x9a\x66\x4b\x33\xd5\x11\xfc\xab\xd1\x80\x1f\xe1\x24\x47\xca\xc5\x74\x3c\x21\x83\xd1\xdb\xee\x70\x70\x81\x54\x69\x56\x9b\x15\x25\x55\xc4\x8b\x02\xb2\x0c\xbd\x1b\xe9\xb6\xdb\x84\x1a\x5f\x2e\x1b\xe7\x99\x4f\x1a\x4d\x35\x17\xaf\xba\x93\x19\xd4\xed\xb7\x52\x66\x37\xe0\x18\x36\xc7\x7e\x39\xf9\xd0\x68\x54\xab\xdf\xc5\xc2\xbb\x59\x7b\x90\x44\x82\x87\x61\xb5\x82\xfb\xaf\x33\xe8\xc0\xc9\x39\x30\xf8\x31\xbf\xee\x39\xb0\xef\xbf\x6f\x68\xa1\x09\xaa\xa0\x83\x5b\xc6\xdd\xe0\x6a\x46\xb7\x32\x3e\x18\x02\x9b\x50\xcb\xbe\xc7\x3e\x34\x8d\x8a\x34\xce\xab\x15\xcd\xc6\xc1\x8c\xf4\xa7\xd3\xba\xa0\xaa\x81\xbc\x70\xcc\x30\x8a\xf3\xa9\x5a\xcd\x09\xea\x93\xb5\xe3\xaf\xaf\xdc\xc5\x6f\xa0\x23\x80\xe3\xa3\x6d\x93\x3b\x3a\xae\x3a\x27\xd4\x1f\xf5\xba\x13\x32\xb8\x1c\x8c\x2e\xfa\x3f\x97\x50\x44\x88\xf9\x83\x10\x40\xc2\x68\xe4\x7b\xf1\x2e\xd2\x08\x49\x9e\x3f\x03\x0d\x43\x58\x80\xf4\x14\xbe\xf1\xaa\x3f\xea\xbf\xed\x1b\x13\xfb\x3b\x51\xb0\x48\x96\xda\x6e\xcc\x38\x19\x4f\xe6\xb3\x73\xe7\xe0\x36\xe7\xa0\x6d\x3a\xc7\x66\xf7\x18\x70\x43\x8c\x4c\x42\x0d\xa6\x8c\xc0\xec\xc7\x9b\x69\xe8\x6a\xe2\x1a\xa9\xc2\x2e\x92\x65\xa3\x91\x31\xa7\x5a\xb2\x61\x1d\x3b\xc2\xe7\x64\x29\xf8\x1a\x65\xb1\x63\xb3\x28\xe2\x0a\x00\x94\x45\x1c\x38\xd2\xff\xb1\xb2\x7a\xae\x65\xb5\x31\x9f\xaa\x15\x9a\xf3\x11\x55\xab\x66\x7e\x0d\x3d\xc8\xe2\x33\x2d\xcd\x24\x42\xd8\xbf\xf6\x7c\x2f\x08\x04\x08\x9e\x28\x2a\xc8\xda\xf3\xa1\x03\xa3\xf1\x45\x9f\x5c\x75\x7b\xe7\x6e\xd6\xdd\x99\x9e\xb4\xe2\x52\x11\x16\x43\x07\x5e\x8f\x67\x73\x32\x98\x58\x17\x26\xa8\x72\xda\x7c\x5e\xea\x03\xdd\xbf\x9d\x23\xb4\x53\xc2\xc5\x19\x91\x54\xdc\x31\x9f\xc2\x91\xbc\xf3\x8b\x4f\x6e\xe9\x03\xe0\xff\x17\xdf\xf1\x15\x41\xb6\xd2\xf4\x1f\x24\xa2\xf7\x8f\xcd\x71\xcf\xef\x38\x0b\xe0\x28\xf0\x94\xd7\x34\xff\x21\x34\x0a\x36\x77\x79\x14\xe0\x7f\xd0\xb7\xa0\xe5\x25\x51\xc8\x6e\x69\xf8\x50\xff\x86\x49\x8c\x62\x2c\xd0\x36\x24\x85\x8f\xcc\xaa\x53\xb5\x6a\x34\x76\xb8\x27\x32\x33\x3c\x44\xad\x83\x1d\x6b\xdd\xdc\x93\x40\xaa\xc7\x97\xba\x78\x7c\x29\x47\x16\x8b\xeb\x28\xe3\xdd\x54\xa1\xdc\xb4\x3b\x9f\x63\x82\xa9\x75\x8a\x49\xf0\x05\xf5\x6c\xa2\x26\x28\x66\x76\x14\xb8\xc0\x34\x94\x45\x4c\x31\x2f\x0c\x1f\x30\xeb\x58\xb2\x28\x00\xaf\x5a\x81\x23\x88\xb9\xa2\x11\x3e\x49\xe7\x2f\x43\x7e\xdf\x32\x59\x20\x93\x20\xe8\xff\x24\x4c\x60\x3a\x4a\x7d\x2f\x91\x26\x38\x4c\xfb\xc3\xee\xbc\x7f\xa1\x17\xe0\x02\xa6\xfd\xc9\xf0\x3d\x18\x19\x29\xef\x96\x62\xc2\x43\x7d\x1a\xd0\xc8\xa7\xc0\xef\xa8\x80\xfe\x6c\xde\x7d\x39\x1c\xcc\x5e\xf7\x2f\x20\x48\x30\xf3\xb1\x1f\x47\x6c\xeb\xbe\xb1\xa6\x91\x92\x2d\x7c\xa0\x1f\x5e\xd0\x18\x2d\x30\xba\x01\x1e\x41\xc0\x84\x81\x4a\x4d\x97\xf2\x4a\x9e\x08\x5c\x5e\x40\x40\xa5\x62\x91\xb6\x70\x40\xa1\x53\x29\xf5\x02\x4c\x82\x27\x65\xb2\xa6\x01\xee\x79\x61\x48\xb7\x13\x5c\x22\xe7\xf3\x48\x79\x2c\xa2\x02\x77\x4c\x05\x5d\x72\x41\x9b\xfa\x6d\x1d\x04\xcd\x37\xdc\x3b\x18\x2b\x58\xe4\xf3\x35\x12\x25\xa8\x8c\x91\xa4\x3b\x8a\x3c\x5d\xd1\x3c\x19\x7a\x81\xfc\x5b\x3c\x51\x37\x1c\xdf\x8a\x3d\xff\x16\xc3\x2e\x93\x20\x15\x17\x46\x52\x1e\x48\x16\xdd\x84\x14\x96\x8c\x86\x38\x92\x12\xa0\xe5\xaa\x49\x83\xf9\xf5\x64\xd8\x27\x97\x04\x53\x6a\x0c\xd6\xee\xef\xc1\x08\x74\xa8\x04\x16\x05\xcc\x47\x11\xdc\xaf\x98\xbf\x2a\x90\x80\x4b\x99\xb5\xfd\x44\x08\x1a\xa9\x10\x99\x1e\x0b\x2a\x53\x96\x1f\x3b\x4f\xdc\x1b\x8f\x46\xf3\x69\xb7\xf7\x86\x0c\xc7\xbd\xee\xb0\x5a\xd1\x7e\x0b\x57\x22\x98\x01\xd7\x6b\x9a\xa6\x1f\x7e\xc2\x91\x26\xaa\x70\xde\xe8\x1a\x50\x63\xf1\xd9\x0f\x3f\x69\xe3\x6b\xa4\xbe\x7a\xc7\x12\xc1\x41\x6b\xec\x7a\x5b\xee\x7d\x5b\xa6\x14\x18\x2f\x5e\xb1\x78\xa5\x63\xbd\x2d\x7c\x6f\x1c\xf2\x2a\x10\x21\xb5\x91\xc1\xfa\x61\x70\x5f\x70\x61\xf3\xdc\x84\x66\x7c\x77\x71\x46\xe8\x47\xcc\x90\x14\x7a\x36\xf3\x9a\xf5\xe4\x29\x10\xb8\xa5\x0f\x4d\xa8\x39\x6f\xd9\x44\xb0\xdb\x7f\x35\xed\xcf\x66\x7a\x33\x1b\x38\x40\xc3\x0b\x1c\xd4\x1f\xe8\x18\x23\xbf\x1e\xbd\x19\x8d\xdf\x8d\xc8\xb0\xad\x71\xc2\x0d\x57\x1c\xe4\x2d\x8b\x9d\x9f\x25\x21\xe7\xb7\x49\x8c\xb8\xc2\x30\xb8\x04\x4b\xe4\x3d\x6b\x8b\x0b\x76\x43\x02\x04\x23\xd0\x41\x77\xdc\x0a\x0c\x76\x45\x07\xa2\x35\xa5\xb7\xa2\xfe\x2d\xfa\xa4\x0d\x4d\x4e\x55\x08\x8d\x69\xcd\xa3\x9b\x82\x11\xe9\x12\x90\x2d\x97\x2c\xa8\x5e\x08\x61\x22\x2c\xbc\xd0\x8b\x7c\x1a\x58\x37\xc2\x63\x2a\xcc\x6a\x4c\x42\x4c\xc5\x92\x8b\xb5\xf6\x28\x68\x6d\x70\x4f\xe1\x86\x83\x5a\x09\x9e\xdc\xac\x74\xf1\x06\xd7\xc1\x4c\xd4\x58\x3c\xe8\x84\x94\x0a\x34\x62\x2f\x0c\xf9\xbd\xb6\x1c\x66\x49\x71\x5e\x2b\xf2\x14\x5a\x00\xfd\xe8\x6a\x54\xbd\xb9\x5e\x47\x67\x26\xda\x06\xf3\xbb\xa2\x51\x10\x73\x16\x29\x09\xf7\x68\xf5\x48\x83\xef\x45\xff\xa1\x80\x46\x3e\x0f\x2c\x02\xd6\xfe\xcc\xac\x96\xb7\x26\x6b\x2e\x5a\x68\x75\x79\xe7\x5b\xb5\x30\x22\x71\x12\x32\x9a\x81\xaa\xd0\x68\x20\x22\x1f\x5d\x0f\x87\x05\x28\xa9\xdf\xf0\xbd\xb0\xa8\x79\xa9\x0e\x65\xda\x63\xd4\xc9\xea\x98\xbc\xf3\x0d\x4c\xa8\xe5\xc5\x7b\x30\xc0\x2c\xd1\xa1\x17\x59\x4a\xe0\x58\xb9\xf6\xe2\x18\xd9\xeb\x7b\x11\x44\x38\x06\x2b\x2f\x8e\x69\x84\xbc\x8a\x90\x55\x4e\xbc\x5a\x22\x90\xc6\xfe\x63\x67\x25\x05\x88\x9a\xc7\xc8\x5b\x76\x55\x46\x75\x81\xe8\xe3\x23\x78\xd7\x9d\x8e\x06\xa3\x57\x2f\x10\x10\x69\xcf\xc7\xe2\x33\x70\x90\xc4\xa8\x6d\xa4\x63\x27\x06\x3e\x4c\xc3\xdc\x1f\x4e\xc1\x30\x6a\x21\x9c\xd5\x1b\xf5\xa4\x44\x2d\xda\xf6\xc8\x4e\x01\xb3\x12\x88\x51\x5e\x5d\xde\x34\x61\x15\xbc\x28\xa7\x53\xa9\x3a\x3a\xbe\xb9\x95\x90\x46\xbb\x09\x4d\xe3\xe2\x97\xde\x4b\x62\x6a\x28\x1f\x5c\xe4\xb3\x25\x95\xd9\x9b\xc1\xc4\x59\x9d\x79\x5d\x1b\x1a\x3a\xe7\xf0\xc1\x8d\xe0\x87\x22\xa0\x1f\x19\xea\xef\x8d\x09\x6d\x2e\x0a\x65\x66\xd2\xca\x09\xc0\x57\x56\xba\x67\xf5\x9a\xad\xb9\x64\x2a\x94\x17\x48\x06\x81\x53\x27\xa5\xf5\x0b\x52\xfd\x72\x42\xc2\x85\x8b\x39\x9c\x16\x50\xb5\x22\xef\x99\xf2\x57\x7a\x82\x56\x70\xdf\x93\x68\x7c\x64\xd4\x7f\xf7\xa2\x5a\x41\x9e\x8f\xe8\x7d\xde\x9c\x4d\xc1\xd7\x3a\x0f\x41\xef\x48\xe4\x29\x62\x4c\x57\xd0\xb5\xc7\x22\x09\x49\xa4\x78\xe2\xaf\x68\x50\xad\xe8\x48\x68\xea\xa4\x66\x4e\x2c\xf8\x1d\x0b\x74\x7d\x5c\x8f\xa2\xc3\xb1\x0a\x89\x99\xdc\x52\x97\xe3\x63\x5d\x53\x6e\xb4\xcc\xfb\x3d\x2b\x3d\xe8\xcd\xad\xec\x74\x88\x34\xe2\x93\x7a\x79\x14\xb8\xe6\x3a\x52\x86\x02\x44\x39\xe1\xbb\x4e\xb8\xa3\xee\xdc\xac\x76\x9c\xda\xb0\xaf\x88\xd1\x8b\x5d\x5c\xce\x78\x0a\x9f\x61\xaf\x95\x85\xa0\xde\xad\xce\x8e\x2d\x4b\x73\x28\xea\x45\xd9\x73\x0b\xcb\x5e\xe4\x47\x26\xc3\xf7\x38\xd7\x20\x2d\xb2\xf6\xc4\x2d\x41\x2f\x80\x76\xa9\xe3\x9a\xa6\xc3\x11\xd7\x2a\x88\xc3\xf8\xab\x9c\xc3\xb2\x4f\x37\xf2\xde\xd4\x55\x69\xc7\x54\x01\x28\x5f\x2d\x65\xcd\x89\xde\x7e\xe9\xfe\x37\x54\x4b\x6b\x4f\x37\x15\x81\x12\x5e\x24\x43\x94\x64\xce\x54\xc2\x7b\xef\x41\x1a\x49\xd2\x00\xe8\x47\x9f\xc6\xca\xba\xfb\x90\xdd\x51\xf1\xa0\xd5\xf9\x08\x31\xa4\xd1\x16\xdf\x0b\x75\x0a\x88\x9e\xdd\xa8\x81\x66\x96\xae\xd3\x23\x7b\xd0\xaa\x10\x48\x87\xd4\x43\x78\xe6\xdd\x78\xcc\xda\xd6\x4e\x2e\x56\x2a\x9f\xf2\xe2\x08\xe8\xd2\x4b\x42\xf5\x62\x03\xb9\x1b\x93\xb7\xd1\x19\xd3\x16\xe8\x40\xdd\xe4\x32\x8d\x7a\xc8\xa3\x9b\x86\xbc\x5d\x20\xec\x51\xde\xb9\x99\x80\x79\xcd\xee\x49\x26\xeb\x31\xd6\xa9\x97\xfb\xbe\xa4\xc4\x89\x6e\x16\xbe\x87\xfe\xfc\x35\x79\x3d\xec\x8f\xe0\x27\x70\xaf\xee\xa9\xc6\xa0\x83\xed\x80\x5d\xd3\xbd\xaa\x69\x42\x88\xd5\xd9\x82\x5c\x39\xbc\x66\x73\x92\x14\x4e\xe4\x83\xae\x76\xa6\x6a\xc5\x22\xe0\x89\x00\x3f\x4c\xa4\xa2\x02\x53\x85\x25\xfb\x98\x46\x54\x0d\xca\xd6\x9e\xf2\x57\xc4\x3c\x21\x67\xed\xba\x05\x8a\x35\x9b\xba\x5a\xd4\x54\xa8\x25\x40\xc7\xe0\xb9\x80\x0a\x76\x47\x89\x1d\xad\x3b\x10\x69\x15\xdd\x4d\xfe\xc6\xa6\xc7\x83\x8b\x06\xfc\xb3\xbc\xce\x91\x69\x63\xae\xa8\x91\xab\x1f\x64\xe8\xb6\x62\x02\x8b\x0d\x23\x1c\xb8\xce\x4f\x70\x9a\xd4\xe5\xb4\xa2\x8e\x36\x2d\x6c\x59\x73\x45\xad\x6e\x6a\x75\xd4\x71\x86\x46\x4b\x8e\x19\x87\x8e\x07\xa6\xf0\x6f\xe6\xec\x57\x3f\x83\x10\x63\x4f\x4a\xa2\x38\x1a\x9f\x7f\x9b\x15\x43\x50\xdf\x5c\x39\x59\x27\xfc\xe9\x06\x8d\xe6\x04\x81\x30\x68\xfe\x97\xd3\xf6\x07\x84\xa0\x96\xcb\xad\x74\xac\x56\xab\xea\xc2\x04\x14\x26\xff\xad\x64\xf2\xdf\x3e\x64\x80\x55\x71\x82\x0f\x73\x84\x58\xfa\xb5\x69\xe9\x5d\x64\x5e\xc8\xb2\xda\x54\x56\x74\x09\xcd\xd9\x6f\x39\x40\xca\x02\x17\x8b\xcf\xca\x80\xc5\x27\xd0\x59\x76\x2a\xdc\xe1\xcf\x3d\x32\xea\xce\xdb\x67\x76\xdf\x69\xea\x9d\x65\x17\x4c\x12\x84\x3e\xd4\x69\x8d\x55\xb3\x0a\x8d\x89\xf2\x58\x48\x7c\x2f\xb4\x70\xad\x37\x18\x0e\xae\xaf\x48\xaf\x3b\x1c\xe2\xa2\x67\x6d\x23\x85\xbc\x39\x5d\x0d\x66\xb3\xfe\x05\x99\x77\x07\x43\x3d\xef\xbc\x0a\x1b\xff\x97\x55\x98\x2c\x89\xdd\xe1\x70\xfc\x8e\xcc\xc7\xe4\xdd\x78\x3a\xbc\xd8\xed\xb4\x53\x7e\x96\x49\x1d\xa5\x6d\x39\xff\xc2\x58\xd4\xa9\xd9\x46\xb1\x52\xa4\xc5\x66\xea\x44\x79\xa5\xb0\xf5\x22\x57\x0f\xd2\xa2\xf1\x75\x83\x9c\x60\x88\xb4\xb0\xf6\xe2\xe5\x2b\x24\x13\x5f\x6c\x02\x93\xc4\xd2\x99\x92\x68\x7c\x7c\x1a\x27\x6d\xb9\xac\x28\xc8\xba\x2e\x4c\x62\xba\x96\x15\xad\x5a\x36\xa3\x4b\x1f\x39\x2a\x5b\x2e\x15\x4c\xa1\xc8\x37\x1d\x98\xf7\x48\xb7\x37\x27\xe3\x37\x5b\xa1\x13\x19\x1a\x21\x47\x2d\xca\xea\x8f\x2e\xc7\xd3\x5e\xff\xaa\x3f\x9a\x6f\xec\x87\xf8\x5e\xac\x12\x91\xdb\x57\xaf\x3b\x99\x5f\x4f\xfb\xe4\xa2\x3f\x1c\xbc\xed\x4f\xdf\x37\x0b\xfc\xd1\x34\xa4\x9f\x32\x45\x89\x7a\x7e\x82\xd9\xba\x73\x0c\xda\x57\x1b\xfc\x37\x9b\xf6\x88\xd6\xd8\x0f\xd0\x49\xb5\xf7\xbc\x38\xc7\xae\xf1\x61\x43\x28\xe7\xdb\x1a\x82\x8f\x1f\x55\x90\x6a\xa5\xb2\xa1\xb7\x35\xbb\x77\x4c\xfc\xc5\x1d\x0d\xac\xe4\xdc\x1e\x2f\xf2\xdb\xdb\xa1\xc5\x4e\xf9\x3e\x55\xab\x05\xcd\x43\xd0\xb1\x4b\x51\x66\xf3\x6e\xef\xcd\x5e\x4d\xd9\xa3\x28\x98\x39\xed\x51\x17\x87\x4f\x53\x7b\xde\xd2\x8e\x6d\xc8\x9a\xc6\x19\x5d\x82\x21\xcb\x90\xdf\x87\xde\x82\x6e\xe4\x62\x4e\x48\x64\xf4\xb2\xb4\x7d\xf1\x6e\x3a\x98\xf7\x11\xbf\x8c\xa7\x8f\xa8\x1c\x62\x60\x0e\x8e\xd7\xc8\x36\x5b\xcf\x0a\x1f\x20\xe0\xf7\x11\x06\x0d\xcd\x44\xed\xe7\x9f\xaa\x9f\x1a\x4f\x59\xc2\xd2\x5d\xa7\x3a\x78\x80\x0a\x96\x6b\xe0\xc9\x39\x1c\x1f\xc1\xc0\x15\x95\x90\x6a\x9d\x73\xe7\x48\xad\x1e\xac\x5f\xda\xa3\x59\x05\x3b\x48\xbf\x3e\x95\xd5\xdd\x57\x5e\x14\x84\x94\xa0\xe8\xca\x4b\xee\xf9\xee\x5d\xb1\xdc\x6e\xfe\x77\xab\x80\x9c\x43\x57\x60\xe0\x15\xe4\x41\x58\x36\x71\x03\x8a\x6d\x4d\xb6\x25\xe8\x92\x32\x7d\x29\x92\xda\x2e\xf1\xdb\x69\x59\x2d\xfe\x5f\x03\xed\x8e\x8f\xe0\xb5\xe6\x22\xc8\x98\xfa\xcc\x0b\x61\xd0\xbb\x9a\xdc\x9d\xc1\x9a\x4a\xe9\xdd\x50\xe9\x2a\xbf\xe6\x54\x80\x04\xea\xaf\xb8\x2e\xd0\x52\x69\xd0\x8d\xab\x86\x84\xfc\x86\x21\x96\x36\xf6\xe8\x8a\x23\x4d\x88\x28\xbb\x59\x2d\x10\xe1\x79\xc1\x1d\x15\x8a\x49\x53\xd8\x75\x59\x9c\x99\xaf\x8b\x28\xd0\x0d\x43\x9b\xf3\xe5\x33\x71\xc4\x4c\x32\x59\xfc\x37\xf5\x95\xa9\x31\x2c\xb9\xb8\xf7\x84\x2e\x05\xb3\xc8\x2e\x93\x15\x6e\x73\xe5\x98\x5c\x50\x3f\x4b\xd1\x00\xa2\x14\xd7\x10\xc5\xcd\xbe\x3d\xcb\x55\xdd\x8a\xdc\xd5\x6d\x95\x3c\x4f\xb7\xf8\xee\xaf\x63\xc3\xf8\x1c\xb7\xd3\x34\x69\x9b\xe1\x1a\x7c\xa5\x71\x10\x5f\x26\x46\x89\x8d\xbd\xb8\xef\x68\x14\x73\x78\x9b\x10\xe1\xa6\xa9\xa2\xc1\xf0\x39\x78\x26\xad\xb6\x09\xce\x52\xb8\xc6\xad\xa9\x1d\xa7\x4c\x80\x3c\x4f\x32\x33\xdc\xee\x65\x69\x43\xb6\xb9\x5a\x46\xa0\xee\x42\x19\x2a\x33\x3c\xa9\x0f\x83\x4c\xde\xb6\xf7\x1b\x6b\xfb\x20\x63\x6d\xef\x30\xd6\xc3\x3a\x5c\xbf\x81\x49\x5b\x83\x6e\x7f\xae\x41\xbb\xc8\x02\x9d\x1c\x5b\x3f\xab\xdf\xd6\xde\xd9\x6f\x6b\xff\x2b\xfa\x6d\x84\x2c\xe8\xf3\x67\x60\x6a\xc8\x2c\x2e\x77\x4c\xc8\x99\xa7\xbb\xa3\x6d\x1d\x6d\xff\xf0\x93\x3b\xf4\xf0\x67\x6e\xde\xdd\xb5\xeb\xc8\x90\xbf\xda\x77\x7f\xb5\xef\x7e\xe7\xf6\x9d\xa1\xc1\x56\x6e\xb4\x7d\xd9\x42\x4d\xc5\x19\x34\x74\x20\x9b\x94\x02\x47\x33\x14\x94\xbd\x68\x1e\xc9\xfc\x23\xb9\x6b\xcd\xc0\x2d\xba\xaf\x0d\xd7\x76\x6d\x38\xb4\x99\x7c\xb7\xad\xbd\xdd\x6d\xab\xfd\xa9\xdb\x6d\x85\xa6\x51\xfb\xc9\x4d\xa3\xf6\x61\x4d\x23\xd3\x22\x32\x8c\xc9\x75\x8e\x8a\x55\xe8\x66\x4e\x72\x5f\xd8\x41\x3a\xa4\xed\xd3\x3a\xbc\xeb\xb3\xab\xed\xd3\xfe\xab\xed\x73\x58\xdb\xa7\xed\x1a\x12\xed\x9c\x02\xfc\xd5\xf7\xf9\xda\x7d\x9f\x9d\x6c\xfe\xa2\xc6\x4f\xb5\x92\x3a\x93\x56\x2c\xf8\xc7\x07\x62\x1d\x49\xc1\xc5\x64\x4f\xfe\x80\xad\xa2\xf6\x46\xab\x68\xbf\xa3\xaa\x40\xc6\xa5\x8c\x91\x87\xb6\x89\x3e\xa3\xf9\x52\xd8\x47\xc6\xc8\x2f\xac\x93\xa6\x35\x2c\xdc\xbd\x01\x3c\xc4\x56\x62\xf5\xf2\xae\x44\x92\x06\x2a\xcb\x0e\xc3\x83\x0a\x94\x50\xe4\x5c\xa7\x0e\x1f\xe9\x44\x17\x63\x1d\xaf\x0e\xd5\xaa\xe3\x23\xe8\x2e\x31\x57\x1f\xb6\xed\x05\x14\x16\xe9\x83\xca\xae\xe3\xff\x22\xef\x45\x75\x0f\x4d\xef\xc2\x39\x24\xcf\xf7\x11\x8d\x68\x4b\x38\x20\xd3\x2a\x6b\x61\xed\x4a\xb2\x2a\xbb\xf2\xaa\xa7\x67\x1a\xbb\x52\x8d\xc7\xea\xd8\xb9\x2a\x98\x75\xda\xdb\x65\xec\xf6\x57\x28\x63\xeb\xb0\xfb\x84\x5a\xf6\x6f\x52\xb0\x76\x65\x85\xaf\xa5\x1f\x07\xa8\xc7\xe1\xda\xf1\xf5\xd2\xcd\x5d\x5a\x96\x43\xad\x79\xa0\xfb\x85\xad\xcc\x7a\xba\x6c\x0d\x06\x93\xb7\x6d\xd2\x1b\x5e\xcf\xe6\xfd\x29\xb9\xea\xce\xde\x34\x4c\x59\x2a\x37\x3a\xed\x8e\x5e\xf5\xcb\x3b\x9b\x9b\x0b\xe1\x02\x65\x3d\x4d\xfd\x30\x5d\x67\x57\x5b\xf3\xf8\x08\x4e\x4f\x5a\x3f\xb7\x4e\x5a\x27\xd0\xf9\xc9\xfd\xfb\xd4\x36\x19\xb3\xaf\x2e\xe2\x25\x89\x14\x5f\x85\xee\x13\x0d\xf8\x15\x4e\xcf\xff\xbf\x74\x46\x33\xa5\xb0\x8c\x7d\xd5\x9d\xf7\xdf\x75\xdf\x7f\x71\x87\xb3\xfd\xe4\x0e\x67\xbb\xac\xa3\xf9\x6f\xdc\x2e\xfc\x3d\xfc\xec\x5f\x3d\xc3\x3f\x6b\xcf\x70\x5b\x5b\x1e\xe9\x19\xa6\xaa\xf1\xd4\xc6\xe1\xf1\x11\x5c\x0e\x7e\xbe\xea\xbf\x80\x77\xee\xc0\xa8\x2e\x03\x99\x6a\x13\xf5\x13\xc1\xd4\x83\x2e\x4a\xd1\x8f\x0a\xbc\xe8\xc1\x9c\x2e\xd5\xff\x23\x75\xde\x68\xea\x66\xe5\x1e\x51\xfb\x39\x4c\xe0\x00\x29\xc2\x35\x71\xad\x35\x85\xa5\xe0\x6b\xcc\x05\x79\xa2\x24\x0b\x4c\xfb\x25\xa2\xea\x9e\x8b\x5b\x5b\xfc\xf9\xab\xfd\xf8\xd5\xdb\x8f\xd9\xe5\x4c\xfc\x4a\xdd\x1e\xf9\xb8\xea\x4e\xf4\xcc\x59\xf1\x10\x08\xc6\x87\x86\x6e\x7b\x68\x92\x0e\xeb\x7d\x58\xaf\x09\x9d\x62\xaf\xc4\x06\x8c\xdd\x35\x12\x49\xa3\x80\x04\x82\xc7\x24\xe2\x8a\x2d\x1f\x08\x15\x82\x0b\xc3\x02\xdd\x67\xb0\x62\x98\xbd\x1e\xcf\x9d\xa5\xa4\x4a\x8c\x21\xcf\x9a\xba\xf9\x8d\x84\xee\x74\xa2\x8b\xa1\x5c\xff\x5a\x01\xa2\x3a\x33\x62\x3b\x7e\x5a\xf7\xd2\x32\x2b\xbe\x30\x35\x93\x51\x14\xf9\xb0\x68\xae\xbb\xbb\x96\x8c\xbe\x77\xfe\x24\x16\x76\xa7\x93\x6d\x0e\x7a\x62\xc7\x4d\xb2\x92\xcb\x4f\x5b\x3d\x21\xbb\x6d\x4f\xc4\xc4\x6e\xd0\xaa\xc9\xda\xf3\x9b\xc5\x90\x7e\x5e\x90\x78\xfd\x5b\xdc\xf5\x0f\xe9\xae\xbf\x6d\x54\xf3\x1d\xad\xe8\x46\x50\x29\x1f\x17\x2c\xb2\x1e\x31\x94\x41\x03\xfe\x22\x15\xed\x61\x26\x76\x39\x1d\x5f\x91\xe1\xcf\x3d\x9b\x9a\xd8\xcf\x12\xb6\x34\x19\x77\xe6\xe1\xb5\x2a\x77\x87\xc3\xf4\xae\x66\xd6\x42\x41\xec\x82\x24\xac\x14\x8f\x64\x1d\x11\xef\x44\x33\xda\xc4\xf8\xfd\x07\x9b\x70\xde\x79\x1a\x95\x77\xd8\x8b\x43\x25\x39\xe4\x90\x9b\x9f\x65\xdd\x29\x76\xb1\xa1\xc0\x55\x8f\x0a\xd4\x66\x75\xa4\x4d\x9a\x07\x93\xb7\x67\x0d\x5b\x56\x72\xad\x0d\x9f\x47\xe8\x0d\x05\x0d\x4c\x81\xdc\x93\x98\x89\xa8\x15\xe2\x50\xad\x44\x80\xfb\xca\x17\x6e\x0a\xe7\x07\x2c\x40\x2c\x56\x46\xb6\xbf\xab\xbf\xba\x9f\x51\xda\xf8\x1f\xe7\xd4\x23\x9f\x42\x76\x3f\xfe\xad\xc3\x84\xb2\xab\x1c\x02\x9b\x05\xef\xe7\x06\x01\x6e\x29\x52\x76\x36\xed\xeb\xf9\x21\x57\x3b\xdf\xf0\x46\x8f\xdf\x8b\xb7\x31\xed\xaa\x3b\x31\x57\xa8\xf7\x5d\x6d\x2f\xbb\xd3\xae\xb3\xa9\xc7\x2e\xb1\x5b\x94\xf1\xd4\x8b\xec\xa7\x27\xcf\xda\xee\x7e\xff\x9e\x7b\xb1\xba\xcb\x6e\x3e\xb1\xef\xd2\xae\xb5\x6d\x77\x4d\x56\x0a\x9f\xe8\x83\x49\x7f\xb6\x73\x2d\xfb\xba\xd8\x1b\x57\x4d\x9b\x70\x47\x45\xc0\x7c\x75\x40\x23\xfa\x80\x66\xf6\xbf\xe2\xcc\xcc\xae\xa4\xee\x91\x13\x16\x4f\xbb\x50\xd7\x3a\xf0\x36\xdb\xee\xfb\x74\xad\x2f\xb9\x4e\xd7\xfa\xdc\xdb\x74\xb9\xa3\x32\x5b\xf7\xe9\xb2\x63\x0e\xb5\xad\x16\xd0\xce\x1b\xff\x85\x99\xb9\x7a\x69\xc3\x02\x70\x73\xc0\x3b\x5f\x9f\xb7\x0d\x02\x2f\x0a\xe0\x1f\x54\x70\x60\xca\x34\x45\x0a\xf5\xf2\x62\xc3\xc1\xca\x4a\xf3\xa4\x25\x0d\x3b\x9e\x3f\xfb\xe5\xf9\x07\xa8\xc1\xc9\xc7\xcb\xcb\xcb\xcb\xf3\x62\x99\x78\x7b\x8d\x7c\x9a\x6c\xd9\x65\x0f\x47\x6c\x71\x58\xd7\x6a\x1f\x13\x4b\x25\x60\x71\x2b\x6e\x43\xad\x03\xff\x9b\x92\x90\x3f\x18\x69\x0e\x2b\xea\xf9\x86\xbd\x81\x3d\x76\x9d\x31\xfa\xb1\x4a\x70\xc9\xb9\x45\x5b\xd5\xb7\x4c\x6e\x19\x71\xd8\x72\xbe\xf1\x42\xc8\x53\xdc\xb6\x06\xe5\x66\x58\x9f\x07\x91\xc9\x1a\x3a\xc6\xbc\x03\xb6\x5c\xd6\x6b\xbb\x79\xd5\x84\x76\x13\x6a\x6e\x21\xfd\x57\xae\xbc\xef\x54\x41\xd0\x38\x4c\xd3\xc0\x92\x9b\x70\x27\x4d\xfc\x66\x53\xbb\xfd\x4b\x32\x99\xf5\xaf\x2f\xc6\xe4\xf5\xc5\x34\x3d\x9d\x59\xdc\x67\x6f\x76\x7d\x45\x86\x6d\xdb\x21\xf8\x54\xdd\x7b\x39\xea\xf1\x2e\xd9\x60\xf4\x59\x6d\xb2\x42\x8a\xb9\xa3\x79\x62\xbb\xd2\xc6\x31\x3e\x73\xa5\x90\x67\x4f\xb8\x75\x03\xbb\x6f\xdd\x14\xfb\x29\x45\xe5\x78\xb6\xd9\x27\x78\x96\x3b\x61\x66\x0e\x77\x18\x56\xe9\xdf\x01\xe3\x11\x05\x1e\x01\x1a\x5e\xa1\x49\xe9\xfb\x3c\x89\xcc\xa5\x9b\xac\xab\xea\x29\x9b\xea\x4a\xa9\x01\x5a\x7a\xbb\x33\xbd\xab\x83\xf8\x2d\x59\x53\x61\x8c\x35\x4b\xad\xbb\x41\x60\x7f\xa5\x0c\x57\x0f\x98\xf4\x16\xa1\xab\xf9\xb9\x8f\x61\x2a\x88\xf6\xee\xe9\xb8\x65\x9e\xd9\x53\x89\x86\xdc\x65\xc9\x0b\xe6\xb7\xcc\x70\xb5\x40\x7f\xd2\x06\x1e\xe8\xb8\xe0\xef\x7b\x11\x31\x45\xec\x7a\x2d\x03\x1d\x56\x29\xb2\x60\x5c\x56\x38\xe8\x74\x6c\xef\x33\x77\xc4\xc0\xad\xbf\xab\xee\x54\x80\xc8\xb9\xae\xdf\xf6\x11\x82\x5c\x17\xe8\x7c\xff\xf5\xb3\xcd\x36\xa4\xd3\xda\xdf\xa6\x0f\xb9\xa7\xb9\x06\xb5\x1a\x14\x39\x05\xbf\xfe\x0a\xd9\x40\xae\x61\x69\x59\x78\x7c\xfc\xe8\xcf\x56\x6c\xce\x09\x3f\xfa\x66\x8a\x39\x98\x68\x66\x64\xee\x2a\x75\x77\x61\x9b\x98\x9f\xea\xcb\x35\xe6\x9c\x65\xcd\x7b\x13\x72\x91\xfb\x39\x9b\xdd\x86\xb6\xa3\x4f\x97\xb9\xa3\xbd\xa7\xc5\xe1\xc4\x12\x53\xee\xdc\xf3\xf7\x6a\x72\x0e\xfe\xf1\xb5\x2b\x39\x53\xea\x79\xa1\x9f\xe8\x1b\x73\xfa\xb0\xd4\x8a\xfa\xb7\xe8\xb5\x97\x4c\xdf\x92\x4e\x24\x15\x20\x63\xcf\xde\xdd\xad\x6c\x3a\x74\xc3\x4d\x4b\xc1\xe9\xd9\x26\x4d\x38\x92\x39\x95\xaf\xe7\xc4\x4b\x7d\x78\x5a\xc4\x3e\x3e\x82\x2b\x4f\xdc\x3a\xff\xa3\x8b\x0e\x93\x6e\xef\x4d\x7f\xae\x0b\x9c\xda\x2b\xe8\x12\x97\xe2\x9a\x5e\xb3\x35\xf7\x23\x46\xfe\xca\x8b\x6e\x28\xc1\x04\xc2\x50\x78\x72\x88\xb4\x76\x15\x5c\x4f\x4a\x7e\xb6\x67\xeb\x68\xec\x63\x79\x41\xfb\xeb\xe5\x05\xbb\x8e\xd0\xfe\x41\x0e\xc7\x1e\x94\x15\x58\xa7\x59\x72\x32\xf6\x2b\x67\x05\x9f\xd7\x41\x7c\x42\x2e\x50\x3c\xc9\x7a\xf8\xf1\x3c\xf9\xe5\xc7\xf0\x1e\x83\xe9\x1b\xe7\xed\x3e\x13\x8c\x7f\xd1\xa1\xa3\xed\xc0\xf4\x18\x94\xda\xbe\xc8\x97\x96\xbb\x8c\x65\xea\x51\x32\x9b\x77\xe7\x7d\x7d\x35\x51\xff\x7d\x48\xa5\xcb\x4c\x7c\xbc\x8f\xb1\x59\x16\x29\x41\x00\xe6\x2c\x6d\xad\xb6\x03\x8e\x65\x57\x29\xe1\x9b\x74\x46\xc8\x79\xbc\xf0\xfc\xdb\xbd\x38\x70\xe3\x48\xcd\xe6\x09\xf2\xb2\x40\xb5\x7d\xa8\xc6\x3d\x99\xf6\xdf\xe2\xa6\xc9\x25\x31\xc7\x50\x67\xdd\x8b\x8b\xe9\x81\xf8\xf0\xf7\x02\x88\xff\x2e\x68\x6d\xdf\xa1\xb1\x2f\x43\x6b\xc7\x47\x30\x1a\xcf\xfb\x2f\xac\xf3\x5f\x79\x12\x16\x94\x46\xf9\x73\x92\xe0\xe9\x03\x1e\xfa\xb2\xbb\xee\xa0\xfc\xbe\x10\x6f\xe3\x66\x01\x64\xc5\xea\xb0\xee\x02\xe8\x56\x6b\xf8\x0f\x7b\xb8\xea\x51\x5c\xe2\x28\xff\xbd\xb0\x89\x73\x5e\xbb\xfb\x32\x46\xbf\x9b\xf6\xa7\x7e\x1b\xf9\x8b\x3c\xfb\x50\x4a\xbe\xeb\xd1\x74\x68\x05\x3a\x50\x42\x9a\xbe\x4f\x52\x00\x31\xc5\x79\x59\x97\x30\x7f\xc8\xf4\xa9\x6d\x82\x5c\xd9\xc4\xd1\xad\xb1\x94\xc3\x51\x05\xf7\x90\x56\xcb\x37\xa1\xdb\xde\x9e\x40\x4e\x23\x0f\xff\x44\x1a\x3e\x0e\xa8\xcb\xbb\x77\x52\x93\x7b\xf4\x1c\xbb\x91\x5e\x5e\x61\x36\x4b\xf4\xf5\xa2\x73\xcc\xc7\x64\x23\x72\x67\x07\xe9\x36\x36\x6a\xf8\x9b\x07\xe0\x9f\xda\x03\x30\x7b\xd9\xab\x1f\x4f\x6f\x41\xe7\x5a\x62\x26\xa3\xb2\x7f\x97\x9c\xbb\x48\xf7\x75\xb2\xdd\x91\xc8\x75\xaf\x3f\x95\xc0\x8d\x27\x75\x33\xcd\xaf\x02\x64\xfd\x4c\xad\x8a\x8a\x1f\xde\x12\x2e\xbe\x80\x7b\x3f\x6d\x37\xcb\xdc\xe3\x41\xe7\xe9\x0f\xe3\x68\xf7\x72\xde\x9f\x92\xb7\x67\xed\xdd\x2d\xc7\xca\x4e\xbc\x05\x7a\xcb\x38\xe5\xb0\x6e\xd9\x7e\xa8\xf5\xd4\x06\xbc\xc5\x7a\x79\x7e\xb7\x2d\xfb\xf6\xdd\x15\x2e\x96\x6f\x0b\x95\x83\xdf\x3b\x69\xda\x7b\x0d\xf8\xf3\x92\x97\x9c\xcb\x4a\x59\xa3\x7d\x56\xdc\x36\xea\x55\x0b\xe2\xaf\xaf\x4e\xed\xb3\x3d\xea\xb4\xe3\x04\x87\x3b\xb8\x61\xe3\xd0\x01\xea\x62\x3d\xeb\xcb\xc9\x25\x19\x0e\x7a\xfd\xd1\xac\x5f\xff\xf6\xd5\x64\xf8\x6d\xe3\xbc\xfa\x7f\x01\x00\x00\xff\xff\xc3\x30\xed\x08\x0c\x62\x00\x00")
+var _bpfBpf_lxcC = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x7c\xfb\x73\x22\x37\xb6\xff\xcf\xf4\x5f\x71\x92\x54\xf9\x0b\x0e\xc1\x2f\xd6\xdf\xad\x21\xa4\x8a\xc1\x78\x86\x1a\x0c\x14\xe0\x99\xcc\x4d\x4d\xa9\x9a\x6e\x61\x74\xdd\x48\x7d\xbb\xd5\x7e\x6c\x76\xee\xdf\x7e\xeb\xe8\xd1\x0f\x68\x30\x76\x66\x77\x92\xad\xd9\xaa\xcc\x1a\xb5\xa4\x3e\x3a\x2f\x9d\x73\x3e\x52\x1f\x1d\x3a\x70\x08\xd0\x15\xe1\x63\xc4\x6e\x96\x12\xaa\xdd\x1a\x9c\x1e\x9f\x9c\xff\x74\x7a\x7c\xf2\xff\xa1\x93\xc8\xa5\x88\x62\x10\x0b\xe8\xb2\x80\x25\x2b\x07\xf4\x80\xd9\x92\xc5\x10\x46\xe2\x26\x72\x57\xc0\x62\x58\x44\x94\x42\x2c\x16\xf2\xde\x8d\x68\x0b\x1e\x45\x02\x9e\xcb\x21\xa2\x3e\x8b\x65\xc4\xe6\x89\xa4\xc0\x24\xb8\xdc\x3f\x12\x11\xac\x84\xcf\x16\x8f\x6a\x22\x26\x21\xe1\x3e\x8d\x40\x2e\x29\x48\x1a\xad\xd4\xcb\xf0\xc7\x9b\xe1\x35\xbc\xa1\x9c\x46\x6e\x00\xe3\x64\x1e\x30\x0f\x06\xcc\xa3\x3c\xa6\xe0\xc6\x10\x62\x4b\xbc\xa4\x3e\xcc\xf5\x44\x38\xe4\x12\xa9\x98\x1a\x2a\xe0\x52\x24\xdc\x77\x25\x13\xbc\x05\x94\xc9\x25\x8d\xe0\x8e\x46\x31\x13\x1c\x4e\xed\x4b\xcc\x8c\x75\x10\x91\x9a\xa5\xea\x4a\x24\x3e\x02\x11\xe2\xc0\x1a\xb8\xfc\x11\x02\x57\x66\x63\x1b\xdb\x58\x90\xad\xd4\x07\xc6\xd5\x7a\x96\x22\xa4\x20\x97\xae\xc4\xb5\xdf\xb3\x20\x80\x39\x85\x24\xa6\x8b\x24\xa8\xab\xd7\xcd\x13\x09\x1f\xfa\xb3\xb7\xa3\xeb\x19\x74\x86\x1f\xe1\x43\x67\x32\xe9\x0c\x67\x1f\x5b\x70\xcf\xe4\x52\x24\x12\xe8\x1d\xd5\x73\xb1\x55\x18\x30\xea\xc3\xbd\x1b\x45\x2e\x97\x8f\x20\x16\x6a\x8a\xab\xde\xa4\xfb\xb6\x33\x9c\x75\x5e\xf7\x07\xfd\xd9\x47\x10\x11\x5c\xf6\x67\xc3\xde\x74\x0a\x97\xa3\x09\x74\x60\xdc\x99\xcc\xfa\xdd\xeb\x41\x67\x02\xe3\xeb\xc9\x78\x34\xed\x35\x00\xa6\x14\x09\xa3\x6a\x86\x1d\x8c\x5e\x28\x61\x45\x14\x7c\x2a\x5d\x16\xc4\xe9\xe2\x3f\x8a\x04\xe2\xa5\x48\x02\x1f\x96\xee\x1d\x85\x88\x7a\x94\xdd\x51\x1f\x5c\xf0\x44\xf8\xf8\xb4\x0c\xd5\x2c\x6e\x20\xf8\x8d\x5a\x2a\xc8\x1c\x37\x5b\xc0\x16\xc0\x85\xac\xc3\x7d\xc4\x24\x05\x29\x36\xa5\xab\xc6\x67\x12\xae\x43\x9f\x7b\x8d\x3a\xfc\xed\x04\x2e\x23\x97\xdf\x06\x8c\xc3\x54\xd6\xe1\x92\x2d\xe4\x12\x2e\x03\x21\xa2\x3a\xbc\x16\xb1\xc4\xae\x57\x1d\x80\xe3\xd3\x93\x93\xe3\x9f\x4e\xce\x8e\x4f\x00\xae\xa7\x1d\x07\x0e\x8f\x9c\x1f\x18\xf7\x82\xc4\xa7\xf0\x33\x17\x3e\x25\x9e\xe0\x0b\x76\xd3\x58\xfe\x92\x7b\x10\x3c\x78\xb9\x76\xe7\x07\x9f\x2e\x18\xa7\xd0\x7b\xdf\x1b\xce\xc8\x74\x74\x3d\xe9\xf6\x60\xf0\x6b\x97\xf4\x2f\x9c\xdc\xa8\x79\xb8\x38\x72\x43\x86\x53\xe5\x5a\x63\xe9\x33\x2e\x8b\xf3\x63\x9b\x58\xeb\x17\x30\x9e\x3c\x1c\x31\x6f\x15\xde\x9d\x17\x1f\x7d\x1f\xb0\xf9\x51\x22\x51\x30\xcb\xef\xd7\x9a\x3d\xb1\x5a\x09\xbe\xd9\xbe\x72\xc3\x92\xde\x6e\x14\x6e\x36\x32\xf5\xc2\x92\xd6\x66\x49\xab\xb7\x0a\x4b\x3a\x53\xb9\xdc\x6c\xf4\xe7\x37\x9b\x8d\xc1\x59\x49\xdb\x83\xb7\xd9\xc8\x5d\xd9\x2c\x79\x53\x28\x02\xe6\x3d\x6e\xb6\x07\xf3\xcd\x36\x3f\x12\xe1\x9e\x64\x79\x71\xb2\x2a\x69\x15\x9c\xcb\xc8\xf5\x6e\x37\x1f\xad\x58\x14\x89\x68\xb3\x7d\x11\x88\x7b\x6c\x4d\x75\x66\x3c\x1a\xf4\xbb\x1f\x49\xff\x02\xaa\x55\xad\x32\xf0\xf3\xcf\x70\x72\x5e\x83\x7f\xc2\xb4\xd7\x1d\x74\x5e\xf7\x06\x35\xc7\x89\x65\x94\x78\x12\xe6\xe1\x82\xd0\x60\x41\x56\x6e\x08\x84\xc4\xd4\x43\xad\xc7\x5f\x31\x74\x67\xe4\xaa\x33\x3e\x87\x36\xfc\xee\xfc\xc0\x16\x3e\x5d\xc0\xdb\xce\xfb\x1e\x19\x4c\xae\xf1\x01\x99\x7d\x1c\xf7\x9c\x4a\x43\x3e\x86\xb4\x52\x69\xc3\xeb\xf1\x65\xda\xac\xfa\xbc\xed\x4c\xdf\xd6\x9d\x1f\x68\x10\xd3\x6d\xdd\x6c\x17\xee\xb3\x85\x53\x69\xc4\xec\x1f\x94\xdc\xd2\xc7\x4a\x1b\xf0\x4f\xb1\xa8\x1a\x2a\x51\x63\x88\x27\x89\x4c\xc2\x80\xd6\xea\xb6\xeb\x9d\x1b\x24\x74\xa3\xb3\x27\x09\xe5\x32\x7a\x54\xfd\x42\xc6\x39\xe3\x37\x95\x36\x8c\xfb\x43\xf2\x66\x30\x7a\xdd\x19\x90\xe1\x14\x1f\xad\xdc\x07\x42\x03\xba\xaa\xb4\xcd\x52\xc9\xb4\xff\x5f\xbd\xba\xf3\xb9\xb5\x3f\x77\x9a\x7f\x12\xee\x34\xff\xad\xdc\xf9\x81\x2d\xe0\x3b\xad\x6e\x3e\x5c\xf4\xa7\x9d\xd7\x83\x1e\x19\x8f\x26\x8a\x8b\x70\x70\x00\xf6\x19\xea\x9f\x6d\x1f\xf7\x87\x6f\xa6\x4e\x2c\x5d\xc9\x3c\x60\x3c\x40\xff\xc6\xb8\x84\x95\x1b\x12\x74\x7f\x22\x91\x96\x46\x42\xe2\x5b\x32\x4f\x16\x0b\x38\x8c\x6f\xe7\x75\xd5\x2d\x68\x12\xb1\x58\xd4\x81\x90\xe4\xef\xc0\xe9\x83\x5c\xfa\x51\xcd\xf9\xdd\xa9\xd8\x75\xc5\xc9\x0a\x7b\xc4\x54\x82\x58\x2c\x50\x2e\x9f\x5b\x4e\x25\x61\x5c\x9e\x9c\x13\x09\x71\x28\x22\xd9\x72\x2a\x38\x17\xab\x43\x44\x65\x2b\x1d\x8b\x8f\xd0\x00\x02\xe1\xb9\x01\x8a\xf7\xb7\x4f\x38\xde\xa9\x54\x36\x17\x50\x41\xf5\xa8\x1c\x1d\x42\xff\x86\x8b\x88\x42\xc2\x6f\xb9\xb8\xe7\x30\x68\xe2\xa6\x2d\x85\x27\x82\x18\x9d\x7e\x85\x2d\xa0\x6a\xe8\x84\xef\xda\xd0\x1f\x8f\x27\xa3\xd9\x88\xcc\xba\x8a\x43\x25\x4f\xae\x2f\xc6\x35\xa7\x52\x89\xa8\x4c\x22\x0e\xc7\xe6\x35\x63\x11\xa9\x05\xc5\x54\xc6\x6a\xdf\xc4\x09\x5c\xee\xc3\xf5\xc5\x18\x30\x1c\xc1\x2d\x2c\x76\x57\x34\x7d\x69\x7c\x3b\x27\x81\x70\x7d\x32\x7f\x94\x34\xae\x2a\x0e\x6a\xee\xc1\x8f\x30\xeb\x8e\xc9\x54\xad\x68\x74\x79\x59\x87\x03\xc5\x96\x7a\xaa\x23\xf8\xab\x56\x83\x9f\xe1\x38\x47\xca\xc5\x64\x34\x26\xfd\xe1\xfb\xce\xa0\x7f\x81\x54\xa1\xef\x22\x7a\xc6\x98\x4a\xe2\x72\x9f\x2c\x02\xf7\x26\xb6\xcb\xad\xc3\x81\x58\x2c\x6a\xad\xcc\x27\x0d\x27\x4a\x3d\xae\x3a\xe3\x29\x54\xcd\xbb\x52\x66\xd7\xe0\x08\xd6\xdb\x7e\x3b\xfe\x54\xab\x39\xce\x0f\x61\xe4\xde\xac\x5c\x48\x78\x24\x82\xc0\xa9\xe0\xfa\xab\x0c\xda\x70\xdc\x02\x06\x3f\xe7\xe7\x6d\x01\xfb\xf1\xc7\x9a\x12\x5a\x44\x25\xb4\x21\x68\x12\x5c\x0d\x8a\x53\xeb\x56\xc6\x07\x4d\x60\x1d\x0e\xb2\xf7\xb1\x4f\x75\xad\x22\xb5\x96\x53\x51\x6c\xec\x4f\x49\x6f\x32\xa9\x46\x54\xd6\x90\x17\x96\x19\x5a\x71\x3e\x3b\x8e\x6d\x38\x6e\x39\x9f\x8d\x1d\x7f\x79\xe5\x2e\xbe\x03\x1d\x01\x1c\x1d\x6e\x9a\xdc\xe1\x91\x63\x9d\x50\x6f\xd8\xed\x8c\x49\xff\xb2\x3f\xbc\xe8\xfd\x5a\x62\x6e\x84\xe8\x1f\x84\x00\x12\x46\xb9\xe7\x86\xdb\x48\x23\x24\x39\x3b\x05\x15\xb4\x30\xbf\xe6\xa4\x8e\x4e\xbf\xe3\x4d\x6f\xd8\x7b\xdf\xd3\x26\xf6\x77\x22\x61\x9e\x2c\x94\xdd\xe8\x76\x32\x1a\xcf\xa6\x2d\xeb\xe0\xd6\xfb\xfc\xfe\xb9\x95\x3a\x36\xb3\x46\x5f\x68\x62\xe2\x24\x50\xc1\xb5\x56\x5c\xf3\xf2\x7a\xba\x75\xd5\xf1\x3d\xa9\xc2\xce\x93\x45\xad\x96\x31\xc7\x29\x59\xb0\xda\x3b\x82\x33\xb2\x88\xc4\x0a\x1d\xcd\x96\xc5\xa2\x88\x2b\x00\x50\xb6\xe3\xc0\xa1\x72\xad\x46\x56\x67\x4a\x85\xd6\xfa\x53\xb9\x44\x73\x3e\xa4\x72\x59\xcf\xcf\xa1\x1a\x59\x78\x8e\xdc\xab\x24\x1c\x93\x84\x95\xeb\xb9\xbe\x1f\x41\x24\x12\x49\x23\xb2\x72\x3d\x68\xc3\x70\x74\xd1\x23\x57\x9d\x6e\xcb\xf6\xba\x3b\x57\x9d\x96\x22\x96\x84\x85\xd0\x86\xb7\xa3\xe9\x8c\xf4\xc7\xc6\x85\x45\x54\x5a\x6d\x6e\x95\xfa\x40\xfb\xb7\x75\x84\xa6\x4b\x30\x3f\x27\x31\x8d\xee\x98\x47\xe1\x30\xbe\xf3\x8a\x4f\x6e\xe9\x23\xe0\x7f\xc5\x31\x9e\x24\xc8\x56\x9a\xfe\x41\x38\xbd\x7f\xaa\x8f\x7d\x7e\x27\x98\x0f\x87\xbe\x2b\xdd\xba\xfe\x3f\x42\xb9\xbf\xbe\xca\x43\x1f\x17\x8b\xbe\x05\x2d\x2f\xe1\x01\xbb\xa5\xc1\x63\xf5\x3b\x16\xe3\x2e\xc6\x7c\x94\x1b\x89\x23\x0f\x99\x55\xa5\x72\x59\xab\x6d\x71\x4f\x64\xaa\x79\x88\x5a\x07\x5b\xe6\xba\xb9\x27\x7e\x2c\x9f\x9e\xea\xe2\xe9\xa9\x2c\x59\x2c\xac\xa2\x8c\xb7\x53\x85\x72\x53\xee\x7c\x86\xe9\x28\x2a\x13\xa6\x76\x5e\x44\x5d\x93\xd6\x45\x14\x73\x48\x0a\x22\xc2\xa4\x95\x71\x26\x99\x1b\x04\x8f\x98\xa3\x2c\x18\xf7\xc1\x75\x2a\x70\x08\xa1\x90\x94\xe3\x93\xb4\xbf\x0a\xff\x74\xce\xc8\x62\x88\xe8\xff\x24\x2c\xc2\xe4\x95\x7a\x6e\x12\xeb\xcd\x61\xd2\x1b\x74\x66\xbd\x0b\x35\x81\x88\x60\xd2\x1b\x0f\x3e\x82\x16\x96\x74\x6f\x29\xa6\x47\xd4\xa3\x3e\xe5\x1e\x05\x71\x47\x23\xe8\x4d\x67\x9d\xd7\x83\xfe\xf4\x6d\xef\x02\xfc\x04\xf3\x24\xf3\x72\x8c\x84\xed\x3b\x56\x94\xcb\xb8\x81\x0f\xf0\x3f\xb8\xa0\x21\xe5\x3e\xe3\x37\x20\x38\xf8\x2c\xd2\xa1\x52\xdd\x26\xc8\xb1\x48\x22\x9c\x3e\x02\x9f\xc6\x92\x71\x65\xe1\x80\x42\xa7\x71\xac\x26\x60\x31\xb8\x71\x9c\xac\xa8\x8f\x6b\x9e\x6b\xd2\x4d\x07\x9b\xf6\x79\x82\x4b\x97\x71\x1a\x35\x60\xb6\xa4\x11\x5d\x88\x88\xd6\xd5\x68\x7c\x6a\xde\x61\xc7\xe0\x5e\xc1\xb8\x27\x56\x48\x54\x44\xe3\x10\x49\xba\xa3\xc8\xd3\x25\xcd\x93\xa1\x26\xc8\x8f\x12\x89\xbc\x11\x38\x2a\x74\xbd\x5b\x2a\x63\x14\x55\x2c\x05\xf2\x95\x71\x70\x21\x66\xfc\x26\xa0\xb0\x60\x34\xc0\x96\x94\x00\xe5\x24\x14\x69\x30\xbb\x1e\x0f\x7a\xe4\x92\x60\x02\x8e\x9b\xb5\xfd\xdd\x1f\x82\xda\x2a\x81\x71\x9f\x79\x28\x82\xfb\x25\xf3\x96\x05\x12\x70\x2d\x7a\x6e\x2f\x89\x22\xca\x65\xf0\x08\x11\x0d\x23\x1a\xa7\x2c\x3f\xb2\x9e\xb8\x3b\x1a\x0e\x67\x93\x4e\xf7\x1d\x19\x8c\xba\x9d\x81\x53\x41\x9f\x43\x70\x31\x04\xf3\xe5\xea\x81\xa2\xe9\xa7\x5f\xb0\xa5\x0e\xd5\xa2\xd1\xd5\xe0\x80\x85\xe7\x3f\xfd\xa2\x8c\xaf\x96\xfa\xea\x2d\x53\xf8\x7b\xcd\xb1\x8d\x80\x78\xe7\xe8\xd8\x8c\xb6\x5e\xbc\x62\xe2\x95\xb6\xf1\xb6\xf0\xa3\x76\xc8\x4b\x3f\x0a\x28\x37\x5b\xf9\x99\xd9\xca\xcd\x1b\xec\xb6\x89\x86\x66\x22\x80\xf9\x39\xa1\x0f\x98\x4f\x49\xcc\x2a\xf4\x30\xe3\xc9\xd3\x40\xe0\x96\x3e\xd6\xe1\xc0\x7a\xcb\x3a\xa6\x02\xbd\x37\x93\xde\x74\x8a\x81\xc0\x7a\x1c\xa0\xc2\x0b\x6c\x54\x2f\x68\x6b\x7f\x71\x3d\x7c\x37\x1c\x7d\x18\x92\x41\x13\xcd\xbf\x72\x23\xa4\x80\xf8\x96\x85\xd6\xcf\x92\x40\x88\xdb\x24\xc4\xb8\x02\x7d\x49\x69\x2c\x61\xbd\x26\x7a\xd6\x86\x88\xd8\x0d\xf1\x31\x18\x81\x36\xba\xe3\x86\xfa\x1b\xd7\x75\x74\xa8\x34\xa5\xbb\xa4\xde\x2d\xfa\xa4\x35\x4d\x4e\x55\x08\x8d\x69\x85\x35\x90\xbc\x11\xa9\x82\x91\x29\xae\xcc\xa9\x9a\x08\xc3\x44\x98\xbb\x81\xcb\x3d\xea\xa3\xe6\xb2\x18\x44\x48\x23\x3d\x1b\x56\x4e\x68\xb4\x10\x11\x1a\xe5\x5c\x59\x1b\xdc\x53\xb8\xc1\xb2\x49\x24\x92\x9b\x25\x6a\xbd\x9a\xc7\x13\x9c\xa3\x79\x09\x0e\xc8\xee\x5b\xac\xbb\x09\x70\x83\x40\xdc\x2b\xcb\x61\x86\x14\xeb\xb5\x38\x96\xae\xb8\x4f\x1f\x6c\x45\xab\x3b\x53\xf3\xa8\xcc\x44\x45\xb9\xf9\x55\x51\xee\x87\x82\x71\x19\xc3\x3d\x5a\x3d\xd2\xe0\xb9\xfc\xff\x49\xa0\xdc\x13\xbe\x89\x80\x91\x7b\x66\xb6\xbc\x35\x19\x73\x51\x11\x5d\x35\xbe\xf3\x8c\x5a\x68\x91\x58\x09\x69\xcd\x38\xb8\xa5\x8f\xb5\x1a\xc6\xea\xc3\xeb\xc1\xa0\x10\x4a\xaa\x11\x9e\x1b\x14\x35\x2f\xd5\xa1\x4c\x7b\x70\x8e\x54\xc7\xe2\x3b\xaf\x8e\xd2\xae\x1c\xe4\xc5\xbb\x77\x80\x59\xa2\x43\xaf\xb2\x94\xc0\xb2\x72\xe5\x86\x21\xb2\x17\xcb\xa2\x1c\xdb\x60\xe9\x86\x21\xe5\xc8\x2b\x8e\xac\xb2\xe2\x55\x12\x01\x33\x9f\x4a\x16\xf4\xe2\x0a\x21\x6a\x3e\x46\xde\xb0\xab\x32\xaa\x0b\x44\x1f\x1d\x62\xa5\x71\xd8\x1f\xbe\x79\x05\x54\x2e\xb1\x2c\x0b\x2c\x3c\x37\xe9\x0b\x78\x5a\x6d\xb9\xda\x3b\x71\xe3\xc3\x34\xcc\xfe\xb0\x0a\x96\xc4\x2a\x93\xd1\x0b\x75\xe3\x18\xb5\x68\xd3\x23\x5b\x05\xcc\x0a\x26\x5a\x79\x55\x31\x54\x6f\xab\xe0\xf2\x9c\x4e\xa5\xea\x68\xf9\x66\x67\x42\x1a\xcd\x22\x14\x8d\xf3\xdf\xba\xaf\x89\xae\xa1\x7c\xb2\x3b\x9f\x29\xa9\x4c\xdf\xf5\xc7\xd6\xea\xf4\x70\xdc\x19\x5c\x74\xce\xc1\xa3\x6d\xc1\x17\x71\xa0\x0f\x0c\xf5\xf7\x46\x51\x90\xee\x42\x99\x99\x34\x72\x02\xf0\xa4\x91\xee\x79\xf5\xc0\xd4\x5c\x32\x15\xca\x0b\x24\x0b\x81\x53\x27\xa5\xf4\x0b\x52\xfd\xb2\x42\x42\xc9\x16\x73\x38\xa5\x55\x4e\x25\xbe\x67\xd2\x5b\xaa\x0e\x4a\xc1\x3d\x37\x46\xe3\x23\xc3\xde\x87\x57\x4e\x05\x79\x3e\xa4\xf7\x79\x73\xd6\xe5\x61\xe3\x3c\x22\x7a\x47\xb8\x2b\x89\x36\xdd\x88\xae\x5c\xc6\x63\x48\xb8\x14\x89\xb7\xa4\xbe\x53\xc1\xd5\x9a\xaa\xaa\xee\x13\x46\xe2\x8e\xf9\xe8\x3e\xf4\xb6\x8b\x0e\xc7\x28\x24\x66\x72\x0b\x2c\x60\xbb\x21\xbe\xc2\xaf\x35\xf4\xf8\xae\x91\x1e\x74\x67\x46\x76\x6a\x8b\xd4\xe2\x8b\x51\x8b\x95\xc0\x15\xd7\x91\x32\x14\x20\xca\x09\xc7\x5a\xe1\x0e\x3b\x33\x3d\xdb\x51\x6a\xc3\x9e\x24\x5a\x2f\xb6\x71\x39\xe3\x29\xbc\xc0\x5e\x2b\xf3\x88\xba\xb7\x2d\x27\x63\x69\x2e\x8a\x7a\x55\xf6\xdc\x84\x65\xaf\xf2\x2d\xe3\xc1\x47\xec\xab\x6b\x8e\x64\xe5\x46\xb7\x04\xbd\x00\xba\x1d\x4c\xa7\x75\x62\x6a\x89\x6b\x14\xc4\xa1\xc4\x99\x77\x58\xe6\x69\xd1\xa6\xb3\x8d\x0e\x15\xa7\x52\x01\x28\x9f\x2d\x65\xcd\xb1\x5a\x7e\xe9\xfa\xd7\x54\x4b\x69\x4f\x27\x15\x81\x8c\x5c\x1e\x23\xc2\x91\x37\x95\xe0\xde\x7d\x54\x16\x2d\xee\xa9\x0f\xf4\xc1\xa3\xa1\x34\xee\x3e\x60\x77\x34\x7a\xc4\x77\x61\x98\xca\x8d\xb6\x78\x6e\xa0\xf2\x4f\xf4\xec\x5a\x0d\x14\xb3\x54\x55\x1f\xd9\x83\x3e\x01\x03\xe9\x80\xba\x18\x9e\xb9\x37\x2e\x33\xb6\xb5\x95\x8b\x95\xca\xe7\xbc\x38\x7c\xba\x70\x93\x40\xbe\xca\x4c\x45\x6d\xea\xda\xe4\xcd\xee\x8c\x69\x0b\xb4\xa1\xaa\x73\x99\x5a\x15\xe1\x85\x5a\x7c\x3b\xc7\xb0\x47\xba\x2d\xa7\x62\xf3\x9a\xed\x9d\xf0\xa9\xcd\x6f\xf0\x37\xfc\x68\x53\xd8\x62\xb2\x58\x83\x1f\xa1\x37\x7b\x4b\xde\x0e\x7a\x43\xf8\x05\xec\xd0\x2d\x89\x85\x9a\x31\xc4\x12\xad\x99\xd3\x0e\x55\x34\x61\x88\xd5\xde\x08\xb9\x72\xf1\x9a\xc9\x49\xd2\x70\x22\xbf\xe9\x2a\x67\x2a\x97\x8c\x03\xc2\x56\x5e\x90\xc4\x08\x56\x85\x11\x5d\xb0\x87\x74\x47\x55\x41\xd9\xca\x95\xde\x92\xe8\x27\xe4\xbc\x59\x35\x81\xe2\x81\x49\x5d\x4d\xd4\x54\xa8\x25\x40\x5b\xad\x97\xf8\x34\x62\x77\x94\x98\xd6\xaa\x0d\x22\x8d\xa2\xdb\xce\xdf\x99\xf4\xb8\x7f\x51\x83\xdf\xcb\xeb\x1c\x99\x36\xe6\x8a\x1a\xb9\xfa\x41\x16\xdd\x2a\x35\x1d\xdb\x6d\x44\x80\x50\x00\x1e\x76\x8b\x55\x39\xad\xa8\xa3\x75\x13\xb6\xac\x84\xa4\x46\x37\xb1\xab\xde\x67\x28\x5f\x88\xc8\xd3\xf1\x87\x81\x09\x74\x9f\xdd\xea\xa7\x23\xc4\xd0\x8d\x63\x22\x05\x9a\xb2\x77\x9b\x15\x43\x50\xdf\xcc\x0a\x75\xc2\x9f\x2e\x50\x6b\x8e\xef\x47\x3a\x9a\xff\xed\xa4\xf9\x09\xda\x6d\x30\x5c\x6e\xa4\x6d\x07\x07\xe8\xf9\x00\xa0\xd0\xf9\x6f\x25\x9d\xff\xf6\x29\x0b\x58\xa5\x20\x38\x53\x8e\x10\x43\xbf\x32\x2d\x65\x44\x66\x01\x99\x26\x2a\x11\x2a\x1b\x25\xd6\x7e\xcb\x03\xa4\x6c\xe3\x62\xe1\x79\x59\x60\xf1\x19\x50\x38\x99\x70\xb1\xec\x3a\xec\xcc\x9a\xe7\x66\xdd\x69\xea\x9d\x65\x17\x2c\x26\x18\xfa\x50\xab\x35\x46\xcd\x2a\x34\x24\x08\x29\x12\xcf\x0d\x4c\xb8\xd6\xed\x0f\xfa\xd7\x57\xa4\xdb\x19\x0c\xc8\xb0\x33\x3b\x6f\xd6\x5a\x39\x7d\x51\xd6\x7e\xd5\x9f\x4e\x7b\x17\x64\xd6\xe9\x0f\x54\xbf\x96\x03\x6b\xff\xcb\x2a\x4c\x86\xc4\xce\x60\x30\xfa\x40\x66\x23\xf2\x61\x34\x19\x5c\x6c\x77\xda\x29\x3f\xcb\xa4\x8e\xd2\x36\x9c\x7f\xa5\x7d\xc3\x89\x5e\x46\xb1\x52\x84\x92\x31\x75\xa2\xbc\x52\x98\x7a\x91\xad\x07\xa1\x35\x57\x3c\x05\xa7\x13\xdc\x22\x4d\x58\x7b\xf1\xfa\x0d\x92\x89\x03\xeb\xc0\x62\x62\xe8\x4c\x49\xac\xc3\x71\x2a\x55\x6b\x97\xc1\x59\x51\x90\x55\x55\x75\xc7\x74\x2d\x2b\x5a\x35\x4c\x46\x97\x3e\xb2\x54\x36\x8c\x15\x67\x99\xd2\x77\x6d\x98\x75\x49\xa7\x3b\x23\xa3\x77\x1b\x5b\x27\xca\x9c\xa3\xd0\x4d\x94\xd5\x1b\x5e\x8e\x26\xdd\xde\x55\x6f\x38\x5b\x5b\x0f\xf1\xdc\x50\x26\x51\x6e\x5d\xdd\xce\x78\x76\x3d\xe9\x91\x8b\xde\xa0\xff\xbe\x37\xf9\x58\x37\x55\x32\xcd\x1f\x25\xe7\xf4\x55\xba\x28\x51\xcd\x77\xd0\x4b\xb7\x8e\x41\x39\x74\x1d\xff\x4d\x27\x5d\xa2\xaa\x8d\x58\xab\xb4\xda\xdb\x2a\xf6\x31\x73\x7c\x5a\x13\x4a\x6b\x53\x43\xf0\xf1\x93\x0a\xe2\x54\x2a\x6b\x7a\x7b\x60\xd6\x8e\x89\x7f\x74\x47\x7d\x23\x39\xbb\xc6\x8b\xfc\xf2\xb6\x68\xb1\x55\xbe\xcf\x8e\x53\xd0\xbc\x57\x4e\x81\xb1\x05\x45\x99\xce\x3a\xdd\x77\x3b\x35\x65\x87\xa2\x60\xe6\xb4\x43\x5d\x6c\x7c\x9a\xda\xf3\x86\x76\x6c\x86\xac\xe9\x3e\x83\x89\x24\x25\x58\xf0\x0a\xdc\x39\x0d\x8a\x2f\xb6\x42\x22\xc3\xd7\xa5\xf0\xc5\x87\x49\x7f\xd6\xc3\x44\x7e\x34\x79\x42\xe5\x30\x06\x16\xc6\x9f\xd7\x95\xc1\x9a\x7a\x56\xf0\x08\x3e\x22\x3d\x98\xde\xa3\xf9\x2a\x3f\xff\x5c\xfd\x44\x85\xb3\x84\xa5\xab\x4e\x75\x70\x0f\x15\x2c\xd7\xc0\xe3\x16\xe2\x02\x7d\x5b\x54\x42\xaa\x31\x34\xca\x93\xea\xec\xad\x5f\xca\xa3\x19\x05\xdb\x4b\xbf\x3e\x97\xd5\xdd\x97\x2e\xf7\x03\x4a\x50\x74\xe5\x25\xf7\x3c\x7a\x57\x2c\xb7\xeb\x7f\x37\x0a\xc8\xb9\xe8\x0a\x74\x0c\x06\xf9\x20\x2c\xeb\xb8\x16\x8a\x6d\x74\x36\x25\xe8\x92\x32\x7d\x69\x24\xb5\x59\xe2\x37\xdd\xb2\x5a\xfc\xbf\x26\xb4\x3b\x3a\x84\xb7\x8a\x8b\x80\xd5\x4b\x2c\xfb\xf6\xbb\x57\xe3\xbb\x73\x58\xd1\x38\x76\x6f\x68\x6c\xb2\x5e\x73\x20\x20\x06\xea\x2d\x85\x2a\xd0\xd2\x58\x47\x37\xb6\x1a\x12\x88\x1b\xe6\x61\xd9\x58\xb9\x6f\x5b\x1c\xa9\x03\xa7\xec\x66\x39\xc7\x08\xcf\xf5\xef\x68\x24\x59\xac\x0b\xbb\x36\x8b\xd3\xf6\xab\x8a\x28\xd0\x09\x02\x8c\x84\x5c\xc6\xf3\x99\x38\xc6\x4c\x71\x32\xff\x6f\xea\x61\x25\x1b\xcb\xd5\x22\xba\x77\x23\x55\x0a\x66\x5c\x8a\xb5\xc2\x6d\xae\x1c\x93\xdb\xd4\xcf\xd3\x68\x00\xda\x19\x20\x8a\x8b\x7d\x7f\x6e\x36\xf6\x4d\xee\x1e\x62\xc9\x3d\xcf\xd3\x0d\xbe\xe3\xd9\x11\x8c\x30\xf2\xdc\x4e\xd3\xa4\x4d\x86\x63\x66\x90\xed\x83\x38\x98\x68\x25\xd6\xf6\x62\xdf\xa3\xa2\x98\xfd\xb2\xc2\xcf\x06\xc3\xd5\x55\x34\x18\x9c\x81\xab\xab\x64\x26\xc1\x59\x44\x16\xb8\x55\x2a\xdf\x48\x99\x00\x79\x9e\x64\x66\x68\x7c\x6e\x0e\xcb\x42\xc2\x0e\x4c\xae\x96\x11\xa8\x50\x28\x4d\x65\x16\x4f\x62\x50\xd5\x1f\xbf\x6f\xee\x36\xd6\xe6\x5e\xc6\xda\xdc\x62\xac\xc5\xb8\x65\x1b\xc2\xf5\x6f\x30\x69\x63\xd0\xcd\x97\x1a\xb4\xdd\x59\xa0\x9d\x63\xeb\x8b\xf0\xb6\xe6\x56\xbc\xad\xf9\xaf\xc0\xdb\x08\x99\xd3\xb3\x53\xd0\x35\x64\x16\x96\x3b\x26\xe4\xcc\xf3\xdd\xd1\xa6\x8e\x36\x7f\xfa\xc5\x1e\x7a\x68\x39\x6b\x56\xfd\x17\x02\xef\xee\x9a\x55\x64\xc8\x37\xf8\xee\x1b\x7c\xf7\x95\xe1\x3b\x6d\x62\xae\x9f\xd9\x97\x29\xd4\x54\xac\x41\x43\xdb\x10\xaa\xdb\x4d\xe0\xa8\x9b\xfc\xb2\x81\xfa\x51\x9c\x7f\x14\x6f\x9b\xd3\x8c\xd9\x0d\xc3\x35\x2d\x0c\x87\x36\x93\x47\xdb\x9a\x9b\x68\x9b\xdd\x9a\xfe\x9a\x70\x5b\x01\x34\x6a\x9a\x89\xed\x7b\x9e\x06\x8d\x9a\xfb\x81\x46\x48\x61\xc5\x30\xc6\xb0\x0b\x91\xa3\x62\x15\xba\x9e\x93\xdc\x1f\x44\x90\xf6\x81\x7d\xac\x97\x2f\x93\xca\x46\x86\x56\x0e\xfb\x34\xbf\xc1\x3e\xfb\xc1\x3e\x4d\x0b\x48\x34\x73\x0a\x90\x97\x88\xcd\xfe\xbe\xe1\x3e\x7f\x04\xf7\xd9\xca\xe6\x8c\xa9\x45\x93\xdb\xcb\xcc\xb0\x44\x65\xc6\x34\xc2\x48\x3c\x3c\x12\xe3\x48\xf2\x33\xe5\x9e\xfc\x09\xa1\xa2\xe6\x1a\x54\xb4\xdb\x51\x55\x20\xe3\x52\xc6\xc8\x7d\x61\xa2\x17\x80\x2f\x85\x75\x64\x8c\xfc\x83\x75\xd2\xb4\x86\x85\xab\xd7\x01\x0f\x31\x95\x58\x25\x27\xe3\xdd\xd3\xb5\x5b\x76\xa0\xdd\x21\x13\x4a\x28\xb2\xae\x53\x9d\xd6\x48\x3b\xda\x3d\xd6\xf2\x6a\x5f\xad\x42\x30\x6d\x81\xb9\xfa\xa0\x69\xae\xab\x30\x0e\x38\xb3\x45\xfc\x5f\xe5\xbd\xa8\xc2\xd0\xd4\x2a\xac\x43\x72\x3d\x0f\xa3\x11\x65\x09\x7b\x64\x5a\x95\x67\x24\x59\x95\x6d\x79\xd5\xf3\x33\x8d\xb2\x70\x7b\x9f\x3a\x76\xae\x0a\x66\x9c\xf6\x66\x19\xbb\xf9\x05\xca\xd8\x6a\xdb\x7d\x46\x2d\xfb\xdf\x52\xb0\xb6\x65\x85\x2f\xa5\x1f\x7b\xa8\xc7\xfe\xda\xf1\xe5\xd2\xcd\x6d\x5a\x96\x8b\x5a\xf3\x81\xee\x1f\x84\x32\xab\xe9\xb4\x07\x80\x55\x12\xd2\x1d\x5c\x4f\x67\xbd\x09\xb9\xea\x4c\xdf\xd5\x30\xfa\x2c\xb4\x4e\x3a\xc3\x37\xbd\x72\x64\x73\x7d\x22\x9c\xa0\x0c\xd3\x54\xf3\xa5\xf3\x6c\x83\x35\x8f\x0e\xe1\xe4\xb8\xf1\x6b\xe3\xb8\x71\x0c\xed\x5f\xec\xdf\x27\x06\x64\xcc\xde\x8a\x17\x79\xb8\x14\xcb\xc0\xbe\x02\xef\xfb\x9c\xe4\x91\xae\xff\x68\x64\x34\x65\xba\x15\xd4\x9b\xce\xac\xf7\xa1\xf3\xf1\x0f\x23\x9c\xcd\x67\x23\x9c\xcd\x32\x44\xf3\x3f\x18\x2e\xfc\x1a\x7e\xf6\x1b\x66\xf8\x57\xc5\x0c\x9b\xcf\xc5\x0c\x53\xd5\x28\x94\xf1\x36\x54\x64\x33\xe7\x39\x3a\x84\xcb\xfe\xaf\x57\xbd\x57\xf0\xc1\x1e\x18\x55\x10\xa2\xf2\x4a\x31\xf5\x92\x88\xc9\x47\xcc\x79\x24\x7d\xc0\x2b\xe4\x8f\xf7\x58\x94\x02\xf5\x4f\xac\x8e\x0b\xaa\x2c\x30\x2c\xf7\x88\xca\xcf\x61\x02\x07\x48\x11\xce\x89\x73\xad\x28\x60\x6d\x1e\x8f\x00\x8a\x44\xc6\xcc\xd7\xf0\x0b\xa7\xf2\x5e\x44\xb7\xa6\xf8\xf3\x0d\x7e\xfc\xe2\xf0\x63\x76\x39\x13\x41\xce\xaa\x39\xf2\x81\x17\x2e\x11\xa8\x9c\x16\x0f\x81\xe0\xc6\x5b\x53\x18\x25\x76\x36\x18\xcf\x53\xd8\x87\xf1\x9a\xd0\x2e\x62\x25\x66\xc3\x28\x09\xe8\x0d\xe9\x31\xe5\x3e\xc1\x8b\xc1\x84\x0b\xc9\x16\x8f\x84\xe2\x3d\x5e\x1c\x88\xa7\x5f\x65\xdd\x8a\x61\xfa\x76\x34\xb3\x96\x92\x2a\xf1\x67\x27\x35\x75\xfd\x45\x85\xce\x64\xac\x8a\xa1\x42\x7d\xdb\x00\xa3\x7e\xdd\x62\x10\x3f\xa5\x7b\x69\x99\x15\x07\x4c\x74\x67\x14\x45\x7e\x5b\x54\x01\x51\x0a\xc9\xa8\x5b\xea\xcf\x62\x61\x67\x32\xde\xe4\xa0\x1b\x6d\xb9\x49\x56\x72\xf9\x69\x03\x13\x32\xcb\x76\xa3\x90\x98\x05\x1a\x35\x59\xb9\x5e\xbd\xb8\xa5\xb7\x0a\x12\xaf\x7e\x8f\xab\xfe\x29\x5d\xf5\xf7\x35\x27\x8f\x68\xf1\x1b\x2c\x0c\x3f\x2d\x58\x64\x3d\xc6\x50\xfa\xbc\x93\x37\x4f\x45\xbb\x9f\x89\x5d\x4e\x46\x57\x64\xf0\x6b\xd7\xa4\x26\xe6\xb5\x84\x61\xf5\x9f\x3e\xd4\x32\x0f\xaf\x54\xb9\x33\x18\xa4\x77\x35\x33\x08\x05\x83\x4c\x24\x61\x29\x05\x8f\xab\x18\x2c\x8f\x15\xa3\xf5\x1e\xbf\xfb\x60\x13\xf6\x33\xbe\x1d\xda\xdb\xec\xc5\x46\x25\xb9\xc8\x21\xd7\x3f\xcb\xba\xd3\xd8\xc5\x6c\x05\xb6\x7a\x54\xa0\x36\xab\x23\xad\xd3\xdc\x1f\xbf\x3f\xaf\x61\x4e\xaf\xef\xac\x13\xed\x41\x0d\x43\x55\x94\x69\x01\x0f\x4f\x70\xf4\x91\x58\x9a\x47\xa7\xbc\x70\x63\xcc\x4f\x10\xbc\xe4\x42\xa9\x16\xe0\x6a\xf3\xe5\x1c\x2b\x56\x75\xaa\xc0\x4c\x58\xac\x97\x6c\x52\xb3\x83\x96\xdd\x4c\x45\xad\xdb\x83\xab\x4f\x10\x80\xa2\x79\xf5\x85\x04\xb8\xad\x74\x02\xeb\xc5\xf1\x33\x94\x63\x89\xd2\x65\xe7\xd8\xca\x8a\x10\x2f\xf3\x59\xb6\xce\xbe\xe6\xb9\x9e\xbe\x43\x6f\xf6\x3f\xbc\xc1\xaa\xae\x5b\xef\xba\x06\x5f\x76\xff\x9d\x90\xe4\xec\xf4\xa9\x0b\xef\x26\x22\x79\xee\xa5\xf7\x93\xe3\xd3\xa6\xfd\x16\xc0\x8e\x3b\xb4\x0a\x91\xd7\xaf\x28\xf7\x31\xfa\xd2\xa8\xf1\x03\xf6\x4a\x2d\xa2\xa1\xea\x10\xd3\x5f\xed\x0c\xcc\x2e\xc4\xdb\x38\xd2\x2c\x29\xba\xa3\x91\xcf\xbc\xdc\x3d\x7b\x5b\x34\x4b\xab\x67\x76\xe8\x1e\xc0\x77\x59\x5d\xa1\x48\xfc\xf3\x2b\x0c\xdb\x12\xc0\x27\x4e\x63\x6c\x45\xef\x4a\xef\xbe\x35\xf6\xbc\xf9\xb6\xfd\xee\x5d\xe3\x8f\x5c\xbd\x6b\xbc\xf4\xe6\x5d\xca\xca\x92\xbb\x77\xf6\x99\x2d\x6a\xe6\xe1\xa2\xad\x5f\x07\x28\xf4\xcc\xd5\x56\x6b\x26\x58\xd7\x87\xc1\xf3\xb5\x7c\x03\x26\x60\xa5\xff\x1f\x34\x12\xc0\xa4\x3e\xdb\x9f\x57\x8f\x62\x69\xdb\xca\x4a\xf1\xa4\x11\x6b\x76\x9c\x9d\xfe\x76\xf6\x09\x0e\xe0\xf8\xe1\xf2\xf2\xf2\xd2\x64\x12\xdb\xe7\xc8\xa7\xd4\x86\x5d\xe6\x20\xc5\x06\x87\x7d\x16\x3e\x2d\x96\x8a\xcf\xc2\x46\xd8\x84\x83\x36\xfc\x6f\x4a\x82\x71\xda\xb9\x83\x8d\x8a\x64\xbd\x2f\xf8\xe6\x88\x76\xc6\xe8\x34\x35\x5e\x73\xd8\x3b\xce\x38\x1a\x04\xc0\x30\xb9\xa1\xc5\x61\x4a\xff\xda\x0b\x21\x4f\x71\xd9\x2a\x80\xc7\xc9\xcc\xd9\x91\x38\x59\x21\x70\x82\x23\x7d\xb6\x58\x54\x0f\xb6\xf3\xaa\x0e\x08\x5a\xd9\x89\xd4\xaf\x1c\x14\x60\x55\x01\x21\x9b\x34\x65\xdc\xc4\x15\xe0\xb8\x0e\x71\xb2\xaa\x2b\xb7\x7f\x49\xc6\xd3\xde\xf5\xc5\x88\xbc\xbd\x98\xa4\x27\x39\x8b\x16\xdc\x9d\x5e\x5f\x91\x41\xd3\xa0\x09\x9f\x9d\x0d\x44\x2d\x7f\xc5\xa7\x00\xf5\xd8\x77\xdb\x84\x46\x21\x6a\xfd\xe1\x8b\x20\xb5\x42\x3a\x6a\x47\xad\x69\x92\x41\xb0\xb5\x63\x3c\xb5\x65\x93\xd3\x67\xdc\xd0\x81\xed\x37\x74\x8a\xd8\x4b\x51\x39\x4e\xd7\x31\x85\xd3\xac\x6c\x3c\x56\x5e\x4f\xe5\xae\x49\x88\x51\x98\x2f\x38\xc5\x73\x1f\x68\x78\x05\x40\xd3\xf3\x44\xc2\xf5\x05\x9d\x34\x0b\x5e\xba\xd2\xa4\xc5\x71\x4c\xfd\xfc\x4d\x50\x5b\x19\xc4\x94\x1a\x2f\x72\x47\xda\x58\xb3\x34\xbc\xe3\xfb\xe6\xfb\x67\x38\xbb\xcf\x62\x77\x1e\xd8\xfa\xa0\x7d\x19\x9e\xdb\x40\x7b\x77\xd5\xbe\xa5\x9f\x99\x13\x8c\x9a\xdc\x45\xc9\x00\x5c\x83\x9e\xcd\x57\xaf\x34\x1b\x0f\xb4\x4d\x5f\xe2\xb9\x9c\x68\x40\xa4\x7a\x90\x05\x1d\x46\x29\xb2\xcd\xb8\xb5\x26\x55\x73\xec\x40\xe3\xa4\x26\x0c\x5f\x3f\x04\x94\xc3\xb5\xf1\x92\x5b\xee\x9a\x59\x4d\xd1\x52\xc9\x7f\xd3\x23\x3d\x68\x61\x7e\xa7\x66\x6a\x29\xde\x56\xf5\x2a\x04\xe8\x4e\x65\xc7\x01\x86\x1c\x06\xd5\xda\x04\x41\xb7\x5a\x46\xce\x0e\x5e\x86\x82\x1e\x1d\xc2\x88\xeb\x9b\xea\x08\x52\xe1\x31\xed\x38\x45\x70\x63\x91\xe9\x0b\x55\x9f\xca\x51\x72\xd1\x0c\x52\x19\x32\xf6\x37\xd1\x66\xb5\x28\x95\x9c\xb5\x3a\x05\xe8\x6d\x6d\xed\xf5\xf4\xc3\x29\x2f\x42\x65\x77\x40\x8d\xf8\xbd\x9e\xa2\x2e\xc0\x3f\xff\x09\x59\x43\x0e\xbe\xb5\x4a\x72\x54\xd8\x13\xcc\xb5\x9b\xb4\xea\x37\x6e\x6d\xf6\xc1\xea\x3c\x96\xaf\xcd\x31\x4d\xdd\x23\x73\xc8\xa9\xa6\x04\x4d\xa2\x3f\x73\x98\x83\x29\xad\xef\xc0\x8f\xfb\x5c\xe4\x3e\xee\xb3\xdd\x95\x6c\x41\x2d\x33\x87\xbb\x7d\x5f\xc1\x8f\x3f\x1d\x3b\x95\xf5\x73\xf9\xb9\xed\x2b\x7f\xcb\x28\xb7\x85\x3d\x3d\x77\x25\xe7\x2c\xba\x6e\xe0\xe1\x97\x60\xf4\x21\x38\x75\x86\x04\xf7\x25\xbc\x7d\xc6\x38\x1e\x17\x89\x20\x0e\x5d\x73\x93\xb9\xb2\xbe\x65\x69\x6e\x1a\x0a\x4e\xce\xd7\x69\x3a\x39\xcf\xbb\xcd\x2f\xb7\x4d\x95\xee\x52\x26\xb4\x52\x8b\xbb\x72\xa3\x5b\xeb\x61\x55\x09\x66\xdc\xe9\xbe\xeb\xcd\x54\xb9\x57\x9d\x49\x41\x33\x41\xef\x88\xf4\xea\xa5\xd9\x4f\x3a\x79\x4b\x97\xdf\x50\x82\x29\x92\xb6\x90\xe3\x7d\xa4\xb5\xad\xfc\x7c\x6c\x54\xde\x8c\x3c\x2e\x3d\x28\xfc\x54\xe6\xd3\xfc\x72\x99\x4f\xf3\xeb\x65\x3e\xfb\x1c\x15\xde\x2b\xef\x31\x4e\xdc\xea\x4e\x6e\xb4\x31\xbb\xec\x0f\x33\xf4\x85\x79\xcf\xcb\xf0\xd4\x67\x64\x3b\xc5\x73\xbd\xdb\xd2\x9d\x2c\xbd\x59\x3b\x58\x68\x92\x19\xe3\x58\xf2\x1d\x0c\x56\xbb\xe3\x50\xe2\x53\x89\xc8\xda\xe9\xc3\x17\xa6\x1b\x6b\x01\xe3\xd6\xb3\x41\x3b\x03\xc6\xfd\x83\xc5\xcd\x6b\x8d\xc6\xaa\xad\x65\xaa\xcb\x8e\x64\x3a\xeb\xcc\x7a\xea\xa2\xa6\xfa\xbd\x4f\xdd\x4f\x77\x6c\xad\x49\xbd\xa4\x72\xb4\x56\xf8\x29\x89\x71\xd4\x99\x22\xdc\xeb\xca\x03\xce\xec\x62\x29\x7c\x97\xf6\x08\x84\x08\xe7\xae\x77\x6b\xf6\xbd\xf2\x48\x77\xed\x80\x91\x95\x67\x99\x8f\xb5\x1b\x55\xca\xd9\x54\x1e\xf6\xc9\xa4\xf7\x1e\x79\x48\x2e\x89\x3e\x94\x3b\xed\x5c\x5c\x4c\xf6\x8c\x80\xbf\x56\x08\xfc\x67\x88\x47\x9b\x7f\xca\x78\x74\xd7\xa1\xbc\xff\xac\x78\x14\x8f\x58\x8e\x66\xbd\x57\x46\x52\x4b\x37\x86\x39\xa5\xbc\x20\x2f\x57\x1d\xe8\x51\x1f\x37\x38\x3c\xca\xb3\xf5\xab\x04\xb1\x6b\x37\x49\xcc\x19\x13\x2c\xad\x07\x55\x1b\x22\x6c\x1c\x05\xf8\xd3\x1e\xa6\x7b\x32\xf2\xb2\x94\x7f\xad\xe8\xcb\xba\xe7\xed\x38\x9c\xce\x5c\xeb\xe6\x43\xd0\xb5\xfc\xc5\xad\x5d\x71\x58\x1e\xe5\xaa\xdb\x78\x0c\xda\x50\x42\x5a\xcb\x31\xa7\x99\x52\x6d\x2f\xf6\xcb\x50\xe1\x96\xf3\x72\x58\x28\x57\xfa\xb2\x74\xdf\xce\x53\xca\xd6\x1c\x60\x8a\x78\xe4\xf6\x50\xd4\xbc\xad\x2f\x29\xbe\xa2\xf9\x8c\x57\xa4\x1b\xe4\x1e\xd8\x8a\x1d\x93\x9a\xdc\x93\xf7\x16\xb4\xf4\xf2\x0a\xb3\x0e\xb3\x6c\x77\x34\x46\xe4\xd6\x0e\xd2\x65\xac\xe1\x30\xeb\x17\x1e\x9e\x8b\xe3\xe8\xb5\xec\xd4\x8f\xe7\x1f\x39\x30\x13\xe2\xfc\x65\x18\x9c\xb9\xe0\x6d\x3a\x95\x1c\xbe\x31\xe3\x4d\xc2\x56\x84\x9a\x0c\xfd\xa3\x77\xeb\xe9\x8b\x0a\x8b\x9e\x07\x69\xeb\x4f\x43\x64\xa0\xb6\xd2\x4f\x29\xf6\x3f\x17\x50\x1c\x80\x0c\x39\x69\xd6\xcb\x7c\x66\xad\xb5\xa9\x32\xf9\x85\xcb\xfd\xd9\xdc\xb9\xc4\x23\x8a\xef\xcf\x9b\xdb\x71\xe7\xca\xd6\x30\x13\x4b\xec\xe7\x4d\x7c\xd9\xee\x18\xd3\x38\xfa\x27\x22\xcc\xe7\x9e\xc2\x30\x21\x6e\x9e\xdf\x4d\xc3\xbe\x5d\x17\xc6\x0b\x45\x13\xbf\x50\x30\xf9\xda\xb9\xa2\x51\x85\x2f\x99\xb3\xe5\xfc\x58\xca\x1a\x14\x0f\x0b\x9b\x5a\xbd\x0e\xfc\xf0\xcb\xab\x53\xf3\x7c\x87\x3a\x6d\x39\xc6\x63\x4f\xef\x98\xcd\x69\x0f\x75\x31\xee\x16\xab\x29\x83\x7e\xb7\x37\x9c\xf6\xaa\xdf\xbf\x19\x0f\xbe\xaf\xb5\x9c\xff\x1b\x00\xe4\xe3\xb2\x84\x3f\x64\x00\x00")
 
 func bpfBpf_lxcCBytes() ([]byte, error) {
 	return bindataRead(
@@ -178,7 +180,7 @@ func bpfBpf_lxcC() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "bpf/bpf_lxc.c", size: 25100, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
+	info := bindataFileInfo{name: "bpf/bpf_lxc.c", size: 25663, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -703,7 +705,7 @@ func bpfLibArpH() (*asset, error) {
 	return a, nil
 }
 
-var _bpfLibCommonH = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd4\x18\x5d\x73\xda\x3a\xf6\xd9\xfc\x8a\x33\xd3\x97\x24\x4b\x9b\x38\x21\x34\x2d\xbd\x77\xc6\x31\x26\xf1\xd4\x18\xd6\x36\x6d\xb3\xdd\x8e\x46\xd8\x72\xd0\xd4\x48\x5e\x59\x26\x61\x7b\xef\x7f\xdf\x91\x6c\xc0\x06\xd2\xdb\xa7\xdd\x59\x1e\x08\xe7\x53\xe7\x4b\xe7\x1c\xe5\xfc\xac\x03\x67\x00\x36\xcf\xd7\x82\x3e\x2e\x24\x9c\xd8\xa7\x70\x79\x61\xf6\x5f\x5f\x5e\x98\x6f\xc1\x2a\xe5\x82\x8b\x02\x78\x0a\x36\xcd\x68\xb9\xec\x40\x25\x10\x2d\x68\x01\xb9\xe0\x8f\x02\x2f\x81\x16\x90\x0a\x42\xa0\xe0\xa9\x7c\xc2\x82\x0c\x60\xcd\x4b\x88\x31\x03\x41\x12\x5a\x48\x41\xe7\xa5\x24\x40\x25\x60\x96\x9c\x73\x01\x4b\x9e\xd0\x74\xad\x15\x51\x09\x25\x4b\x88\x00\xb9\x20\x20\x89\x58\xea\xc3\x14\x70\xe7\xcf\xe0\x8e\x30\x22\x70\x06\xd3\x72\x9e\xd1\x18\x3c\x1a\x13\x56\x10\xc0\x05\xe4\x0a\x53\x2c\x48\x02\xf3\x4a\x91\x12\x19\x29\x2b\xc2\xda\x0a\x18\xf1\x92\x25\x58\x52\xce\x06\x40\xa8\x5c\x10\x01\x2b\x22\x0a\xca\x19\x5c\x6e\x0e\xa9\x35\x76\x81\x0b\xad\xe5\x04\x4b\x65\xbc\x00\x9e\x2b\xc1\x53\xc0\x6c\x0d\x19\x96\x3b\xd9\x37\x2f\x85\x60\xe7\x69\x02\x94\x69\xed\x0b\x9e\x13\x90\x0b\x2c\x95\x9b\x4f\x34\xcb\x60\x4e\xa0\x2c\x48\x5a\x66\x5d\xad\x63\x5e\x4a\xf8\xec\x46\xf7\x93\x59\x04\x96\xff\x00\x9f\xad\x20\xb0\xfc\xe8\x61\x00\x4f\x54\x2e\x78\x29\x81\xac\x48\xa5\x8b\x2e\xf3\x8c\x92\x04\x9e\xb0\x10\x98\xc9\x35\xf0\x54\xab\x18\x3b\x81\x7d\x6f\xf9\x91\x75\xeb\x7a\x6e\xf4\x00\x5c\xc0\xc8\x8d\x7c\x27\x0c\x61\x34\x09\xc0\x82\xa9\x15\x44\xae\x3d\xf3\xac\x00\xa6\xb3\x60\x3a\x09\x9d\x37\x00\x21\x51\x86\x11\xad\xe1\x27\x81\x4e\x75\xb2\x04\x81\x84\x48\x4c\xb3\x62\xeb\xfc\x03\x2f\xa1\x58\xf0\x32\x4b\x60\x81\x57\x04\x04\x89\x09\x5d\x91\x04\x30\xc4\x3c\x5f\xff\x75\x0e\xb5\x16\x9c\x71\xf6\xa8\x5d\x05\xd9\x88\xe6\x00\x68\x0a\x8c\xcb\x2e\x3c\x09\x2a\x09\x48\x7e\x98\x5d\x2d\xbf\xcb\x70\x17\x5c\x16\xbf\xe9\xc2\xb5\x09\x23\x81\xd9\xf7\x8c\x32\x08\x65\x17\x46\x34\x95\x0b\x18\x65\x9c\x8b\x2e\xdc\xf2\x42\x2a\xd6\xb1\x05\x70\x71\x69\x9a\x17\xaf\xcd\xab\x0b\x13\x60\x16\x5a\x1d\x38\x3b\xef\xbc\xa2\x29\x4b\x48\x0a\x08\x79\xee\x2d\xb2\x27\xe3\xf1\xc4\x47\xf7\xa8\xf3\x2a\x21\x29\x65\xe4\x00\xdf\x79\x45\x59\x9c\x95\x09\x81\x0f\xf3\x3c\x45\x29\xc1\xb2\x14\xa4\x78\xb3\xf8\xbd\x4d\x39\xc7\x39\x6d\x23\x33\xca\xca\xe7\x73\x9a\xaf\xfa\x47\xf1\xac\x8d\x2d\x64\x42\x99\x54\xb8\xad\x89\xce\x27\xc7\x8f\x50\x38\x99\x05\xb6\xb3\xb5\xaf\x89\x84\x8b\xce\x2b\xc2\x12\x9a\x76\xb6\xe4\xe9\xc4\x73\xed\x07\x34\xb6\xa6\x28\x74\xff\xe1\x18\xfd\xeb\xeb\xab\xfe\x96\x1a\x38\xa1\x13\x7c\x72\x86\xa8\x66\x53\x2c\x60\x5e\xde\x74\x1a\xee\x53\x96\x51\x46\x10\x02\x84\xb0\xac\x8b\x1d\xa1\x93\x13\x9c\x3d\xe1\x75\x51\x93\x4f\x4f\x77\x22\xaa\x9c\x2b\x55\x27\x58\x88\x53\x38\x29\xe8\xbf\x09\x4f\x2b\xe0\x1c\x6a\x48\x83\x5f\x2f\xbe\x35\x25\x6d\xd7\x73\x67\x63\x64\x5b\x9e\x87\x86\xc1\x64\x8a\xfc\x49\xe4\x8e\x1e\x0c\xc3\x30\x8f\xf2\x38\x41\x30\x09\xb6\x4c\x97\x47\x79\x42\xc7\x1f\x22\xd7\x1e\x4f\xfb\xc8\xb1\xef\x27\x28\x70\xa6\xde\x83\x71\x75\x94\xf7\xde\xf2\x87\x9e\x53\x73\xfb\xa1\x61\xf4\xfe\x4a\x65\xe4\x8e\x1d\xe4\x7c\xb1\x1d\x67\xe8\x0c\x8d\xeb\xa3\xec\x56\x30\x35\x0c\xc3\xe8\x1f\x25\xba\xd3\x4f\x3d\xc3\x30\xde\x1e\x25\xfa\x56\xd4\x57\xd4\x9b\x97\xa8\xbd\xbe\x61\x18\xef\x8e\x1b\xa9\xb2\x6d\x18\xe6\x45\xa7\x23\xd7\x39\xa9\x4a\xbc\xec\xf7\x60\x89\x63\x24\x07\x9d\x4e\xc9\x54\x53\x5c\xf5\x71\x92\x08\xf8\xd1\x81\xfa\x53\x48\x51\xc6\xb2\x81\xd8\x7c\x10\x2a\xaf\x2e\x21\x37\x07\x2f\x51\x2e\x5f\xa4\x5c\xbd\x48\xe9\xed\x28\x7f\xee\x7e\x22\x54\xde\x80\xb2\xeb\xab\xd9\xff\x36\xe8\xfc\x39\x68\xd6\x73\x10\xa9\x62\x1e\x5b\x5f\xc0\xec\x77\x3a\xb5\xb9\x39\x17\x72\x89\x73\xf8\xd1\x31\x10\x2a\xcd\x3e\xa4\x82\x2f\x07\x1b\x40\xf2\x4a\x49\xcd\x9c\x3d\xc7\x88\xb2\x94\xd7\xdc\x57\x97\x86\x41\x53\xca\x12\xf2\xbc\x91\x30\x8c\x82\xc4\x28\xc3\x73\x92\x6d\x95\xec\x3e\x5a\x3e\x19\x74\x0c\x1d\x4a\x43\xfd\xd9\x01\x8c\x27\x04\x55\x98\x66\x84\x0d\x9a\x0f\x3a\xc6\x9e\xb5\x9b\x1f\x5f\x1b\x5e\x7d\x6b\x99\x9a\xf3\x8c\xc6\x6b\x44\x98\x14\xeb\x86\xb9\x38\xd6\x13\x6e\x0b\xe7\x38\xa9\x00\x55\x2e\x39\x8e\xbf\x13\x59\xec\x10\xf3\xb5\x24\x45\xa5\x96\xb0\x72\xa9\xf4\xd4\x95\x52\x5d\x1d\x34\xf3\xc3\xa9\x63\x77\xf7\xd1\xea\x0a\x1e\x22\x6f\xef\xd0\x38\xbc\x3b\x8a\xb7\xad\x69\x34\x0b\x9c\x6e\x2b\x63\x35\x7d\xd3\x41\x87\x01\xfc\x53\x5b\x76\x63\x18\xaa\x30\x07\x3b\xb0\x28\xe7\x4d\x8c\x4e\x03\x2f\x45\xbc\xc5\x28\x57\x17\xb8\x58\xec\xe2\x93\x08\x9e\x23\xc6\x25\x4d\x75\x78\x0e\xce\xda\x8a\x65\x84\x21\x2e\xe8\xe3\xa0\x85\x89\x71\xbe\x43\x14\xa2\x95\x72\x85\x4a\x0a\x79\x0c\x45\x93\xc1\x61\xe5\x68\x9f\xeb\x4e\x7d\x3b\x1d\xa1\x11\x9a\x86\xce\x6c\x38\xd1\x66\xbc\x82\x3a\x1a\xfb\x94\xfd\x7b\x71\x62\xce\x3c\x0f\x3e\x7c\x80\xde\xe9\x41\x2f\x77\x43\xd5\xf1\x4e\x9e\x4f\xe1\xa4\x64\x19\xfd\x4e\xb2\xf5\xc9\xc9\x33\x7c\x80\x8b\x53\xf8\xe3\x0f\x38\x79\x86\xdf\x7e\x83\xc8\x46\x96\x1d\xa1\xf0\x7e\x12\x9d\xaa\xde\x7a\x7e\x56\x6f\x71\x40\x84\xe0\x02\x62\x9e\x90\xa2\x0b\xcb\xb2\x90\x2a\x35\xc0\x57\x44\x64\x38\xaf\x06\x72\x64\x83\x20\xb2\x14\xac\x62\xd3\xf3\xb1\x3e\x5c\xf7\x63\xd7\xff\x64\x79\xee\x10\x85\x63\xcb\x36\xd4\x20\x3d\x4e\x1e\xd6\x64\xf3\x05\x69\x77\xaa\xa8\x97\x6d\x6a\x35\x82\x0c\x45\xb9\x3a\x2a\xa7\x49\xbd\x36\xc9\x8e\xb6\x5a\xef\x87\x81\x62\xb8\x3e\x60\x18\xbb\x61\xe8\xfa\x77\xc8\xb2\x3f\x2a\x86\xfe\x01\xc3\xcc\xff\xe8\x4f\x3e\xfb\x68\x1a\x4c\xa2\x89\x62\x79\x7b\xc0\x62\x5b\x7e\x84\xec\xc0\xb1\x22\x47\x31\xdc\xb4\x19\x36\x0a\xbc\x2b\x6d\xe3\xbb\x36\x55\x9d\xef\x0c\x51\x64\xb9\x9e\x6e\xcd\xc6\x6b\xb3\xb7\x17\xb8\xcf\x81\x1b\x39\xd5\x38\x53\x54\xf3\x05\xf5\x3d\xa5\xbe\x77\x79\x9c\xaa\x06\x12\xb2\x27\x43\x65\x60\xef\xea\x27\x3c\xd1\xc3\x54\xf3\xf4\x5e\xe6\xe9\x6f\x15\x5d\xff\x8c\x69\xa3\x69\x2f\xa4\xfe\x04\x45\x33\xdf\x77\x3c\xf4\xd1\x79\x50\xf4\xb7\x2f\xd1\x27\xd3\x48\xd1\x6f\x8e\xd7\xc9\x9d\xe3\x3b\x9f\xf4\x01\xef\x8e\x5b\x11\x59\xc1\x9d\xa3\x34\x5c\x5f\xec\x9f\xe0\x23\x6f\x62\x5b\x9e\x0a\xd8\xb5\x79\x70\xbc\xf7\xc5\xd6\x94\xbd\x50\xda\xe1\x6c\x5c\x27\xf1\xfa\xea\x18\x49\x27\xe0\xfa\xb0\x06\xab\xca\x40\x23\xcb\xf5\x9c\xa1\x62\xb9\x3e\xee\x91\xf3\x25\xaa\xca\xf4\x7a\x2f\x64\xa3\xc0\xba\x43\xfe\x24\x9c\x4d\xd5\x28\x50\x0c\x87\x31\x53\xab\x9a\x6b\x3b\xda\x84\x9b\x63\x77\x67\x63\xdf\x3b\x7d\xeb\x8b\xef\xf3\xd7\xbf\xc7\xf3\xaf\xdf\xa0\x2c\xf0\x23\x79\xaf\x2e\xf3\xb6\xfd\xdf\xa2\x30\xb0\x91\x67\xdd\x3a\x5e\x57\x83\xee\xc8\xf5\x87\xce\x97\x0a\xa8\xd4\x55\xbf\xf5\x96\x81\xc2\xc8\x8a\x9c\x0a\xa1\x9a\x4b\x05\xa9\x8e\x77\x7e\x06\xa1\xc4\x92\xc0\x0a\x67\x25\x29\xf4\xe3\x41\x8b\x34\x8f\xab\x74\xd8\x9e\x63\x05\x5d\x0d\xf5\x7b\xdd\x1a\xdb\x9e\x15\x76\x84\x9c\xbb\x40\x3d\x63\x2e\x9a\x38\xd7\xaf\x90\xe6\xb6\xef\xab\x2d\x1a\xc5\x12\xc9\x32\xcf\x08\xfc\x50\x8d\x57\xf5\x5d\x7b\xe2\xfb\x51\x60\xd9\x1f\xab\xec\xef\x8d\x60\xf5\x35\xe8\xbc\x22\x59\x41\xf6\x28\x49\x45\x6a\x23\x8b\x0d\xbf\xee\xc2\xc6\xf9\x19\x44\x0b\x02\x5c\xa8\x87\x2b\x4f\x21\x51\xc3\xfb\x6f\x85\xfa\xae\xba\x29\xe3\x52\x3d\xf5\xe2\x05\x66\x8f\x24\x51\xfe\x6f\xa6\x98\x66\x6d\xec\x16\x3b\xf0\xc6\x30\x18\x79\x96\x0b\x7d\x7a\x05\xa7\x19\x7e\x2c\x5a\x4b\x00\xcd\x57\xbd\x5f\x71\x16\xa1\x39\xd1\xdb\x41\xd3\xcf\x0d\x72\xe3\xe2\x06\xfe\x1f\x7b\xb7\xff\x9a\xd0\x4b\x4b\x72\x7a\xba\xf3\x3a\x96\xad\xb5\xa7\xdf\x03\xf1\x8c\xf6\x76\x1b\x85\xaa\xb7\x9b\x1a\x21\x0f\x79\x64\x8b\xc7\xec\x43\x46\x53\x22\xe9\x92\x6c\x11\xe2\x19\xc5\x19\x2f\x28\x7b\x7c\x6f\x76\x3b\x46\x35\x89\xe5\x31\x24\xc3\xb2\xd7\x6f\xc0\xd9\x1c\x65\x9c\xe7\x73\x1c\x7f\x6f\x60\x05\x29\x88\x58\x91\xf7\xe6\xe5\xee\x08\xb2\x42\x0c\x4b\xd4\x5a\x33\xd5\xdb\xf7\x79\x8d\xaa\x80\x35\x17\xd4\x79\x1f\x7d\x27\xeb\xc6\x12\xde\x5a\xd6\xd5\x17\x29\x8a\xd6\xbe\x6c\xf6\xab\x94\x0d\x0c\x95\x4a\xaf\xa7\x57\x4b\x48\x69\x26\x89\xe8\xaa\xa7\x75\xc9\x0a\x22\xbb\x80\xb3\x4c\x93\x0a\xc0\x79\x9e\xad\x77\x79\x84\x22\xc3\x2b\x52\x89\xdf\xaa\x08\xb2\x04\xa8\x24\x02\x4b\xf5\x8a\xbe\x00\xca\x12\x1a\x63\x49\x0a\xfd\x2c\x5f\xe2\x42\x12\x01\xca\x4d\x1a\x13\xa5\xe5\x17\x32\xaa\xdc\xda\x48\xfc\x68\x5f\x36\x90\x58\x3c\x12\xb9\x0b\x4c\xa3\xa4\x20\xe6\x25\x93\x7f\x11\xc9\x27\x42\x1f\x17\xf2\x97\x0a\x4b\x99\x21\xc8\x8a\x88\x82\x28\x45\x07\xa6\x6c\xc3\xdb\xb2\xe5\x97\x14\xf7\xea\xb4\xd5\xb7\xec\xff\x38\x53\xbd\x66\xa6\x6a\x6f\xfe\xab\x39\xea\xed\xe7\x68\x3f\xa4\xbf\x9c\x9d\xf3\x73\xf0\x6e\x51\x10\xa8\xd7\x15\x0a\x9d\xbf\xc3\xa3\xfe\xe7\x94\xd4\xff\x46\x84\x04\x93\x25\x67\x40\x19\xe8\x97\x5b\xcc\x59\x4a\x1f\xdf\x2c\x76\x86\xa0\x82\xfc\xab\x24\x6c\x13\x89\x43\x67\x69\xf2\xfc\xb5\x75\x40\xfb\x09\x17\x4b\x54\xe8\x09\xf9\xe3\xe7\xd1\x79\xb9\x8f\x24\xef\xcd\xeb\x2d\x9b\x7a\xc4\xa0\x56\xcb\x6d\x75\x91\x66\x98\x76\x50\xb1\x8a\x51\x85\x69\xbe\x2d\x95\x58\x0f\xc9\x79\xb6\x57\xb5\xc5\x56\x78\x57\xac\x70\x7e\x56\xfd\xaa\xa6\xc1\x9c\xa8\x88\xa5\x82\x33\xa9\xa6\x85\xee\xf9\x5d\xc8\x38\x4e\x48\x52\x3d\x25\x7a\xa0\x9b\x2e\x08\x82\x93\x66\xed\x36\xa6\x03\x6c\x87\xc3\x2f\x54\x44\xc3\x5a\xbd\x6a\x34\xec\xad\x42\xd2\x32\xfa\x48\x94\x76\x5d\xff\x67\xa7\xd5\xf3\xf0\x3f\x01\x00\x00\xff\xff\x6e\xca\xc4\xe7\x23\x17\x00\x00")
+var _bpfLibCommonH = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x58\x5f\x73\xdb\x38\x0e\x7f\x96\x3f\x05\x66\xfa\x92\xe4\xdc\x26\x4e\x1c\x37\x5b\x77\x77\x46\x91\xe5\x44\x53\x59\xf2\x49\x72\xdb\x5c\xaf\xc3\xa1\x25\x2a\xe6\x54\x26\x7d\x24\xe5\xc4\xd7\xdd\xef\x7e\x43\x4a\xb6\xfc\x2f\x6d\xdf\xee\xe1\xce\x99\x71\x4c\x00\x04\x81\x1f\x40\x02\xe4\xf9\x59\x0b\xce\x00\x1c\xbe\x58\x09\xfa\x38\x53\x70\xe2\x9c\xc2\xe5\x45\xa7\xf7\xfa\xf2\xa2\xf3\x16\xec\x52\xcd\xb8\x90\xc0\x73\x70\x68\x41\xcb\x79\x0b\xaa\x09\xc9\x8c\x4a\x58\x08\xfe\x28\xf0\x1c\xa8\x84\x5c\x10\x02\x92\xe7\xea\x09\x0b\xd2\x87\x15\x2f\x21\xc5\x0c\x04\xc9\xa8\x54\x82\x4e\x4b\x45\x80\x2a\xc0\x2c\x3b\xe7\x02\xe6\x3c\xa3\xf9\xca\x28\xa2\x0a\x4a\x96\x11\x01\x6a\x46\x40\x11\x31\x37\x8b\xe9\xc1\x5d\x30\x81\x3b\xc2\x88\xc0\x05\x8c\xcb\x69\x41\x53\xf0\x69\x4a\x98\x24\x80\x25\x2c\x34\x45\xce\x48\x06\xd3\x4a\x91\x9e\x32\xd4\x56\xc4\xb5\x15\x30\xe4\x25\xcb\xb0\xa2\x9c\xf5\x81\x50\x35\x23\x02\x96\x44\x48\xca\x19\x5c\xae\x17\xa9\x35\xb6\x81\x0b\xa3\xe5\x04\x2b\x6d\xbc\x00\xbe\xd0\x13\x4f\x01\xb3\x15\x14\x58\x35\x73\xdf\xbc\x04\x41\xe3\x69\x06\x94\x19\x7f\x66\x7c\x41\x40\xcd\xb0\xd2\xbe\x3f\xd1\xa2\x80\x29\x81\x52\x92\xbc\x2c\xda\x66\xb9\x69\xa9\xe0\x93\x97\xdc\x87\x93\x04\xec\xe0\x01\x3e\xd9\x51\x64\x07\xc9\x43\x1f\x9e\xa8\x9a\xf1\x52\x01\x59\x92\x4a\x17\x9d\x2f\x0a\x4a\x32\x78\xc2\x42\x60\xa6\x56\xc0\x73\xa3\x62\xe4\x46\xce\xbd\x1d\x24\xf6\xad\xe7\x7b\xc9\x03\x70\x01\x43\x2f\x09\xdc\x38\x86\x61\x18\x81\x0d\x63\x3b\x4a\x3c\x67\xe2\xdb\x11\x8c\x27\xd1\x38\x8c\xdd\x37\x00\x31\xd1\x86\x11\xa3\xe1\x07\x40\xe7\x26\x58\x82\x40\x46\x14\xa6\x85\xdc\x38\xff\xc0\x4b\x90\x33\x5e\x16\x19\xcc\xf0\x92\x80\x20\x29\xa1\x4b\x92\x01\x86\x94\x2f\x56\x3f\x8f\xa1\xd1\x82\x0b\xce\x1e\x8d\xab\xa0\xb6\xd0\xec\x03\xcd\x81\x71\xd5\x86\x27\x41\x15\x01\xc5\x0f\xa3\x6b\xe6\x37\x11\x6e\x83\xc7\xd2\x37\x6d\xb8\xee\xc0\x50\x60\xf6\xad\xa0\x0c\x62\xd5\x86\x21\xcd\xd5\x0c\x86\x05\xe7\xa2\x0d\xb7\x5c\x2a\x2d\x3a\xb2\x01\x2e\x2e\x3b\x9d\x8b\xd7\x9d\xab\x8b\x0e\xc0\x24\xb6\x5b\x70\x76\xde\x7a\x45\x73\x96\x91\x1c\x10\xf2\xbd\x5b\xe4\x84\xa3\x51\x18\xa0\x7b\xd4\x7a\x95\x91\x9c\x32\x72\x40\x6f\xbd\xa2\x2c\x2d\xca\x8c\xc0\xfb\xe9\x22\x47\x39\xc1\xaa\x14\x44\xbe\x99\xfd\xb1\xcb\x39\xc7\x0b\xba\x4b\x2c\x28\x2b\x9f\xcf\xe9\x62\xd9\x3b\x4a\x67\xbb\x54\xa9\x32\xca\x94\xa6\x6d\x4c\x74\x3f\xba\x41\x82\xe2\x70\x12\x39\xee\xc6\xbe\x6d\x22\x5c\xb4\x5e\x11\x96\xd1\xbc\xb5\x61\x8f\x43\xdf\x73\x1e\xd0\xc8\x1e\xa3\xd8\xfb\x87\x6b\xf5\xae\xaf\xaf\x7a\x1b\x6e\xe4\xc6\x6e\xf4\xd1\x1d\xa0\x5a\x4c\x8b\x40\xe7\xf2\xa6\x99\x8f\x10\x65\x05\x65\x04\x21\x40\x08\xab\x3a\xd9\x11\x3a\x39\xc1\xc5\x13\x5e\xc9\x9a\x7d\x7a\xda\x4c\xd1\xe9\xfc\x60\x56\x3b\xc1\x42\x9c\xc2\x89\xa4\xff\x26\x3c\xaf\x06\xe7\x50\x8f\xcc\xf0\xcb\xc5\xd7\xed\x99\x8e\xe7\x7b\x93\x11\x72\x6c\xdf\x47\x83\x28\x1c\xa3\x20\x4c\xbc\xe1\x83\x65\x59\x9d\xa3\x32\x6e\x14\x85\xd1\x46\xe8\xf2\xa8\x4c\xec\x06\x03\xe4\x39\xa3\x71\x0f\xb9\xce\x7d\x88\x22\x77\xec\x3f\x58\x57\x47\x65\xef\xed\x60\xe0\xbb\xb5\x74\x10\x5b\x56\xf7\x67\x2a\x13\x6f\xe4\x22\xf7\xb3\xe3\xba\x03\x77\x60\x5d\x1f\x15\xb7\xa3\xb1\x65\x59\x56\xef\x28\xd3\x1b\x7f\xec\x5a\x96\xf5\xf6\x28\x33\xb0\x93\x9e\xe6\xde\xbc\xc4\xed\xf6\x2c\xcb\xfa\xed\x28\x57\xe3\xaf\x81\xbb\x68\xb5\xd4\x6a\x41\xaa\x14\x2f\x7b\x5d\x98\xe3\x14\xa9\x7e\xab\x55\x32\x7d\x28\x2e\x7b\x38\xcb\x04\x7c\x6f\x41\xfd\x91\x4a\x94\xa9\xda\x22\xac\xff\x10\x2a\xaf\x2e\x61\xd1\xe9\xbf\xc4\xb9\x7c\x91\x73\xf5\x22\xa7\xdb\x70\xfe\x6a\x7e\x22\x54\xde\x80\xb6\xeb\x4b\xa7\xf7\xb5\xdf\xfa\xab\xdf\xa4\xc8\x38\x8c\x12\x9d\xcc\x23\xfb\x33\x74\x7a\xad\x56\x6d\xee\x82\x0b\x35\xc7\x0b\xf8\xde\xb2\x10\x2a\x3b\x3d\xc8\x05\x9f\xf7\xd7\x03\xc5\x2b\x25\xb5\x70\xf1\x9c\x22\xca\x72\x5e\x4b\x5f\x5d\x5a\x16\xcd\x29\xcb\xc8\xf3\x7a\x86\x65\x49\x92\xa2\x02\x4f\x49\xb1\x51\xb2\x36\x0e\xaa\xf9\x59\xbf\x65\x19\x28\x2d\xfd\xaf\x19\x30\x9e\x11\x54\x51\xb6\x11\xb6\xe8\xa2\xdf\xb2\xf6\xac\x5d\xff\xf8\xb2\xe5\xd5\xd7\x1d\x53\x17\xbc\xa0\xe9\x0a\x11\xa6\xc4\x6a\xcb\x5c\x9c\xea\xf3\xaf\xbf\x19\x2f\xb0\x36\xc7\xc4\x57\x0f\xd2\x6f\x44\xc9\x86\x30\x5d\x29\x22\x2b\xb5\x84\x95\x73\xad\xa7\xce\x94\x6a\xeb\xa0\x49\x10\x8f\x5d\xa7\xbd\x4f\xd6\x5b\xf0\x90\x78\x7b\x87\x46\xf1\xdd\x51\xba\x63\x8f\x93\x49\xe4\x1e\xf0\x86\x7e\xf8\xa9\xbd\x13\xc6\x9a\xb1\x3e\x56\x07\x11\xfc\xd3\x98\x7b\x63\x59\x3a\x5b\xfb\xcd\x50\x96\xd3\x6d\x8a\x89\x0d\x2f\x45\xba\xa1\x68\xff\x67\x58\xce\x1a\xd0\x32\xc1\x17\x88\x71\x45\x73\x83\xd9\xc1\x5a\x9b\x69\x05\x61\x88\x0b\xfa\xd8\x00\xa9\x29\x29\x5e\x34\x04\x29\x76\xf2\x40\xcb\x64\x52\x1d\x23\xd1\xac\x7f\x98\x4e\x5b\xa1\xcc\x0b\xfe\xf4\x4b\x56\xfd\x7c\x49\x0d\x42\xa6\x73\xa7\xbf\x46\x89\x91\x67\x35\xcb\xc4\x66\x6c\x12\xe2\xaf\x7e\x53\x3b\x6e\xc7\x43\x34\x44\xe3\xd8\x9d\x0c\x42\xb3\xda\x2b\xa8\x43\xb1\xcf\x59\x67\xf9\xfa\x73\xd2\x99\xf8\x3e\xbc\x7f\x0f\xdd\xd3\x83\xea\xe2\xc5\xc8\x8d\xa2\x93\xe7\x53\x38\x29\x59\x41\xbf\x91\x62\x75\x72\xf2\x0c\xef\xe1\xe2\x14\xfe\xfc\x13\x4e\x9e\xe1\xf7\xdf\x21\x71\x90\xed\x24\x28\xbe\x0f\x93\x53\x7d\xda\x9f\x9f\xd5\x7d\x25\x10\x21\xb8\x80\x94\x67\x44\xb6\x61\x5e\x4a\x05\x41\x98\x00\x5f\x12\x51\xe0\x45\xd5\x22\x24\x0e\x08\xa2\x4a\xc1\x2a\x31\x53\xb1\xeb\xc5\x75\x7a\x22\x2f\xf8\x68\xfb\xde\x00\xc5\x23\xdb\xb1\x74\x69\x3f\xce\x1e\xd4\xec\xce\x71\x76\xec\x8d\xf5\xe4\xa6\x82\x18\x6e\x55\x14\x2d\xcd\xb9\x3a\x3a\xcf\xb0\x9a\x1a\x61\x58\x4e\xb2\xd1\x7a\x3f\x88\xb4\xc0\xf5\x81\xc0\xc8\x8b\x63\x2f\xb8\x43\xb6\xf3\x41\x0b\xf4\x0e\x04\x26\xc1\x87\x20\xfc\x14\xa0\x71\x14\x26\xa1\x16\x79\x7b\x20\xe2\xd8\x41\x82\x9c\xc8\xb5\x13\x57\x0b\x34\x25\xc2\x08\xac\x15\xf8\x57\xc6\xc6\xa6\x44\x18\xae\x5e\xdf\x1d\xa0\xc4\xf6\x7c\x53\x85\xac\xd7\x9d\xee\x1e\x70\x9f\x22\x2f\x71\x75\x70\x43\xed\x43\xb7\xf3\x82\xfa\xae\x56\xdf\xbd\x3c\xce\xd5\x25\x12\x39\xe1\x40\x1b\xd8\xbd\xfa\x81\x4c\xf2\x30\x36\x32\xdd\x97\x65\x7a\x1b\x45\xd7\x3f\x12\x5a\x6b\xda\x83\x34\x08\x51\x32\x09\x02\xd7\x47\x1f\xdc\x07\xcd\x7f\xfb\x12\x3f\x1c\x27\x9a\x7f\x73\x34\xde\xe8\xce\x0d\xdc\x8f\xc6\x8a\xdf\x8e\x5b\x91\xd8\xd1\x9d\xab\x35\x5c\xef\xe1\x19\x84\x01\xf2\x43\xc7\xf6\x35\x60\xd7\x7b\x70\x06\x21\xf2\x3f\x3b\x86\xb3\x07\xa5\x13\x4f\x46\x75\x10\xaf\xf7\x10\xac\x58\x26\x00\xd7\x87\x39\x58\x65\x06\x1a\xda\x9e\xef\x0e\xb4\xc8\xf5\x71\x8f\xdc\xcf\x49\x95\xa6\xd7\x7b\x90\x0d\x23\xfb\x0e\x05\x61\x3c\x19\xeb\xe2\xa4\x35\x1c\x62\xa6\x9b\x47\xcf\x71\x8d\x09\x7b\x88\xd5\x0d\x65\x6d\xdf\x6f\x66\xd7\xcb\x6f\xd3\xd7\x7f\xa4\xd3\x2f\x5f\xa1\x94\xf8\x91\xbc\xd3\x9b\x79\x53\x90\x6e\x51\x1c\x39\xc8\xb7\x6f\x5d\x5f\xd7\x8f\x5b\xe4\x0d\xbd\x60\xe0\x7e\xae\x06\x95\xba\xea\xb7\xe9\x7b\x50\x9c\xd8\x89\xa9\x34\xb7\xda\xdd\x7a\xa4\x4f\xbc\xf3\x33\x88\x15\x56\x04\x96\xb8\x28\x89\x34\xd7\x19\x33\x65\x7b\x39\x43\x40\x8e\xef\xda\x51\xdb\x8c\x7a\xdd\x76\x4d\xdd\x2d\x54\x4e\x82\xdc\xbb\x48\x5f\xac\x9a\x80\x9a\x2d\x5e\x11\x3b\x9b\xe3\x5d\xf7\xf5\x28\x55\x48\x95\x8b\x82\xc0\x77\xdd\xb4\xeb\x73\xd7\x09\x83\x20\x89\x6c\xe7\x43\x15\xfd\xbd\xa6\x40\x7f\xf5\x5b\xaf\x48\x21\xc9\x1e\x27\xd3\xdf\xfb\x3d\x84\x5c\xcb\x9b\x1e\xdf\x3a\x3f\x83\x64\x46\x80\x0b\x7d\x95\xe6\x39\x98\x92\xf0\x37\xa9\xbf\xab\xd3\x94\x71\xa5\x2f\x9f\xe9\x0c\xb3\x47\x92\x69\xff\x8f\x54\x0f\x3d\x94\x3f\x2c\x26\x79\x81\x1f\xe5\x4e\x2d\xa3\x8b\x65\xf7\x57\x9c\x45\x68\x4a\x74\x7d\xdc\xf1\x73\x4d\x5c\xbb\xb8\x1e\xff\x97\xbd\xdb\xbf\xdf\x98\x36\x2a\x3b\x3d\x6d\xbc\x4e\xd5\x4e\x23\xd6\xeb\x82\x78\x46\x7b\xdd\x96\x26\xd5\xfd\x56\x4d\x50\x87\x32\x6a\x47\xa6\xd3\x83\x82\xe6\x44\xd1\x39\xd9\x10\xc4\x33\x4a\x0b\x2e\x29\x7b\x7c\xd7\x69\xb7\x2c\x5d\x87\x01\xd4\x31\x22\xc3\xaa\xdb\xdb\x1a\x17\x53\x54\x70\xbe\x98\xe2\xf4\xdb\x16\x55\x10\x49\xc4\x92\xbc\xeb\x5c\x36\x4b\x90\x25\x62\x58\xa1\x9d\xc6\x57\xbf\x6d\x3c\xaf\x50\x05\xd8\x56\xc0\x8b\x69\x0f\x7d\x23\xab\xad\x6b\xc1\x76\x62\x9a\x5e\x9d\x48\xb9\xd3\xc1\x77\x7a\x55\xc8\xfa\x96\x0e\xa5\xdf\x35\xcd\x2e\xe4\xb4\x50\x44\xb4\xf5\x65\xbf\x64\x92\xa8\x36\xe0\xa2\x30\x2c\x09\x78\xb1\x28\x56\x4d\x1c\x41\x16\x78\x49\xaa\xe9\xb7\x1a\x65\x96\x01\x55\x44\x60\xa5\xef\xf5\x17\x40\x59\x46\x53\xac\x88\x34\x0f\x05\x73\x2c\xf5\x83\x8d\x76\x93\xa6\x64\xad\xe5\x46\x3b\xa4\x78\x63\x83\x1e\xa5\xbc\x78\xc9\x8e\x9a\xbd\x67\xcb\x0d\x54\xfd\xd4\xcf\x53\x44\xe3\xb4\x36\xe1\xfb\xee\xee\x05\x85\xc5\x23\xd9\xa4\x25\x6c\xe7\x28\xa4\xbc\x64\x35\x52\x23\xe3\xc8\x3b\x60\xe5\x7c\x5a\xed\x6b\x83\x83\x6c\x57\x78\xbc\xd3\x26\x1b\x83\xf5\x53\x8e\xbe\x58\xc2\x19\x4c\xd7\xf0\x48\xc8\x04\xa6\x8c\xb2\x47\xfd\xec\x06\x8c\x3c\x41\xca\x19\x23\xe6\xbe\x20\x01\x8b\xcd\x14\x41\x32\x2a\x48\xaa\x1f\xae\xcc\x53\x0b\x95\x95\xfe\xb5\xcf\x2f\x66\xc8\x13\xd1\x6f\x86\xbf\x8c\x86\x20\xfa\xfd\x8d\x68\x45\x07\x88\x6c\xd2\xa6\x56\x5d\xa7\xdd\xaf\x28\xee\xd6\xe9\x58\x9f\x1e\xff\xcf\xc0\x26\x03\xbb\xdb\x19\x58\xc3\xf3\xbf\x98\x7b\xdd\xfd\xdc\xdb\x4f\x95\x5f\xce\xba\xf3\x73\xf0\x6f\x51\x14\xa1\x91\xfd\x19\xc5\xee\xdf\xe1\xd1\xbc\x9c\xea\xbd\x33\x5d\x41\x86\xc9\x9c\x33\xfd\xfc\x6b\xee\xfe\x29\x67\x39\x7d\x7c\x33\x6b\x0c\x41\x92\xfc\xab\x24\x6c\x1d\x90\x06\xf3\xf5\x88\x66\xcf\x5f\x76\x16\xd8\x7d\x04\x48\x15\x92\xa6\xa3\xf9\xfe\x63\x74\x5e\x3e\xf7\xb3\x77\x9d\xeb\x8d\x98\xbe\xf1\xa2\x9d\x12\xb9\x73\xea\x6f\xef\xa8\x66\x24\x97\x29\xaa\x28\x5b\x86\x99\x69\x5d\xa4\xa6\xc5\xde\x6e\xac\x4b\x79\xad\xbd\x5a\x0b\xce\xcf\xaa\x82\x50\x55\xef\x29\xd1\x88\xe5\x82\x33\x65\xce\x38\xcd\x69\x43\xc1\x71\xa6\xdf\xbb\xf5\xd5\xaf\x0b\xa6\x48\x82\x20\x78\xab\xba\xc3\x76\x35\x87\x4d\x31\xff\x85\x8c\xd8\xb2\xd6\xb4\x86\x5b\xf6\x56\x90\xec\x18\x7d\x04\xa5\xa6\x4a\xff\x68\xb5\x57\x84\x65\x34\x6f\xfd\x67\x00\x20\xe9\x48\x17\x65\x19\x00\x00")
 
 func bpfLibCommonHBytes() ([]byte, error) {
 	return bindataRead(
@@ -718,12 +720,12 @@ func bpfLibCommonH() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "bpf/lib/common.h", size: 5923, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
+	info := bindataFileInfo{name: "bpf/lib/common.h", size: 6501, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _bpfLibConntrackH = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x7c\x7f\x6f\xdb\x38\x93\xff\xdf\xf6\xab\x98\xee\x02\xfd\xda\x79\x5c\xe7\x47\xfd\xcd\x3d\x88\x37\x5d\x38\x8e\xd2\x1a\x75\x6d\x9f\xa3\x6c\xb7\x38\x1c\x04\x59\xa2\x62\x5e\x64\x52\x47\x52\x49\x8c\x6d\xee\xb5\x1f\x86\xa4\x64\xc9\x96\x9d\xb4\xdb\xeb\xd3\x67\x9f\x14\xd8\xb5\x25\x92\xc3\x21\x67\x38\xf3\x99\xe1\x38\xfb\x7b\x75\xd8\x03\xe8\xf3\x64\x29\xe8\xf5\x5c\x41\xa3\xdf\x84\xa3\x83\xc3\xe3\x57\x47\x07\x87\xff\x06\xbd\x54\xcd\xb9\x90\xc0\x23\xe8\xd3\x98\xa6\x8b\x3a\x98\x01\xee\x9c\x4a\x48\x04\xbf\x16\xfe\x02\xa8\x84\x48\x10\x02\x92\x47\xea\xce\x17\xa4\x0b\x4b\x9e\x42\xe0\x33\x10\x24\xa4\x52\x09\x3a\x4b\x15\x01\xaa\xc0\x67\xe1\x3e\x17\xb0\xe0\x21\x8d\x96\x9a\x10\x55\x90\xb2\x90\x08\x50\x73\x02\x8a\x88\x85\x9e\x0c\x1f\xde\x8e\xae\xe0\x2d\x61\x44\xf8\x31\x4c\xd2\x59\x4c\x03\x18\xd2\x80\x30\x49\xc0\x97\x90\xe0\x1b\x39\x27\x21\xcc\x0c\x21\x1c\x72\x81\x5c\x5c\x5a\x2e\xe0\x82\xa7\x2c\xf4\x15\xe5\xac\x0b\x84\xaa\x39\x11\x70\x4b\x84\xa4\x9c\xc1\x51\x36\x89\xa5\xd8\x02\x2e\x34\x95\x86\xaf\x90\x79\x01\x3c\xc1\x81\x4d\xf0\xd9\x12\x62\x5f\xad\xc6\xb6\xb7\x6d\xc1\x6a\xa5\x21\x50\xa6\xa9\xcf\x79\x42\x40\xcd\x7d\x85\xcb\xbc\xa3\x71\x0c\x33\x02\xa9\x24\x51\x1a\xb7\x34\x8d\x59\xaa\xe0\xe3\xc0\x7d\x37\xbe\x72\xa1\x37\xfa\x04\x1f\x7b\xd3\x69\x6f\xe4\x7e\xea\xc2\x1d\x55\x73\x9e\x2a\x20\xb7\xc4\xd0\xa2\x8b\x24\xa6\x24\x84\x3b\x5f\x08\x9f\xa9\x25\xf0\x48\x93\xf8\xe0\x4c\xfb\xef\x7a\x23\xb7\x77\x36\x18\x0e\xdc\x4f\xc0\x05\x5c\x0c\xdc\x91\x73\x79\x09\x17\xe3\x29\xf4\x60\xd2\x9b\xba\x83\xfe\xd5\xb0\x37\x85\xc9\xd5\x74\x32\xbe\x74\xda\x00\x97\x04\x19\x23\x9a\xc2\x8e\x8d\x8e\xb4\xb0\x04\x81\x90\x28\x9f\xc6\x32\x5f\xfc\x27\x9e\x82\x9c\xf3\x34\x0e\x61\xee\xdf\x12\x10\x24\x20\xf4\x96\x84\xe0\x43\xc0\x93\xe5\xe3\x32\xd4\x54\xfc\x98\xb3\x6b\xbd\x54\x50\x85\xdd\xec\x02\x8d\x80\x71\xd5\x82\x3b\x41\x15\x01\xc5\x37\xa5\xab\xc7\xaf\x24\xdc\x82\x01\x0b\xda\x2d\xf8\xff\x87\x70\x21\x7c\x76\x13\x53\x06\x97\xaa\x05\x17\x34\x52\x73\xb8\x88\x39\x17\x2d\x38\xe3\x52\x61\xd7\x0f\x3d\x80\x83\xa3\xc3\xc3\x83\x57\x87\xaf\x0f\x0e\x01\xae\x2e\x7b\x75\xd8\xdb\xaf\xff\x4c\x23\x16\x92\x08\x3c\x6f\x38\x38\xf3\xfa\xe3\xd1\xc8\x9d\xf6\xfa\xef\xbd\x77\x5e\xfd\xe7\x90\x44\x94\x91\xaa\xa6\xfa\xcf\x94\x05\x71\x1a\x12\xf8\x25\xa6\x2c\xbd\xdf\xa7\xc1\x22\xb9\x3d\x6e\xcf\xdf\x54\xb6\xe0\xfb\x55\xc3\x4f\x01\x5f\x2c\x38\x6b\xcf\x7f\x2a\xbc\xa3\x7a\x78\xf1\x4d\x38\xbb\x2e\xbf\x88\x3b\xf8\x9c\xb3\xd5\x77\xbd\x73\xe7\xa2\x77\x35\x74\xbd\xe1\xe0\xc2\x19\x7c\x70\xe0\xf5\xf1\x41\xbd\x4e\x58\xba\x80\x3f\xea\xb5\xbe\xeb\x8d\x9c\x8f\x2d\xfd\xc5\xb9\x74\x7b\x67\xc3\xc1\xe5\x3b\xe7\xdc\xbc\x98\x3a\x93\xe1\xa7\xec\xeb\xb0\xe7\xe2\xfb\x87\x2e\x32\x19\xe1\x6e\xe4\x8b\x5d\x4d\xe7\x5e\x4d\x86\x8e\x77\xe1\x8d\xaf\xdc\x5a\xed\xa0\xb6\xbf\x07\xe3\x54\x5d\x73\xca\xae\x21\x8a\xf9\x9d\xde\xcb\xb5\xae\x83\x51\xad\x76\x88\x3d\x07\x2c\xe0\x8b\x5d\x3d\x2d\x0f\xb5\xda\x11\x76\xbf\xc0\x5e\x82\x24\x82\x48\xc2\x94\x04\x41\xf0\x34\x86\x90\xf8\xc1\x0d\x51\x12\x09\xe4\xab\xec\xf5\xdd\xc1\x78\xe4\x5d\x8d\x2e\x27\x4e\xbf\x95\x3f\xf7\xa7\x4e\xcf\x75\x0a\xcf\xc3\xf1\x65\xe1\xf1\xdc\x19\x3a\xd8\x8c\x4b\x96\xca\x57\x34\x00\xca\x62\xe4\x88\x32\x05\x9e\x67\x1e\x3c\x0f\x3c\x2f\x50\x5e\xcc\xf9\x4d\x9a\x34\x6e\x39\x0d\x61\x6f\xe1\x27\x2d\x90\x4a\xa4\x01\x76\x94\x37\xde\x2c\x8d\x22\xd8\x93\x37\xb3\x56\xbd\x86\xff\xc0\xf4\x53\x69\x12\x93\x96\xa6\xe7\x07\x46\x5d\xf1\x7b\x48\x45\xd6\xcf\x12\x09\x94\x87\x2c\x10\xd8\xcb\xbe\x35\xeb\x7f\xd4\x6b\xab\x56\xc2\x94\x58\xc2\x9e\xfe\xe8\xd6\x6b\x48\x45\x10\xd5\xad\xd7\x6b\x34\x82\x46\xc3\x34\x9f\xc2\xc2\x4f\x2c\xa7\x1e\x89\xc9\xa2\xa1\x19\xd5\x5c\x34\x9b\x4d\xdc\xaa\x5a\xa0\xcd\xb9\xa7\x84\x1f\x90\x06\x32\x0c\xe7\x67\x6f\xbd\xbe\xeb\x7d\xe8\xb9\xfd\x77\x2d\xd0\x94\x5e\xbd\x89\x69\x44\x14\x5d\x10\xcd\xa7\x7d\x97\x08\x7e\xbf\xf4\x12\x2e\x14\xfc\xf2\x0b\x1c\x1e\xc3\xe7\xac\xb7\x20\xb7\x1e\xf3\x95\x47\x59\x48\xee\x9b\xdd\x7a\x3e\x24\x23\x03\xa7\x15\xaa\x8a\xfd\x90\xfb\x7c\xc9\x9a\xc1\x5a\xf6\xb8\x46\x16\x4e\x2b\x67\xeb\x96\x87\xc4\x9c\x27\x33\x3f\xb8\x59\xf5\x8e\x67\x5e\xf6\x72\xad\x6f\x61\x3d\x79\xef\xd5\x3b\xec\xfc\x90\x1f\x86\xe1\xef\x7d\x6f\xd4\x73\x3b\xc7\xf5\x1a\x6a\xa7\xf1\x01\x5a\x15\x81\x11\x12\x4a\x60\xbe\xea\x1c\x83\x12\x3e\x93\xb1\xb6\x4c\xa8\xa1\x66\x7d\x96\xb4\xe9\xf1\xf2\x25\xbc\x90\x37\xb3\x57\x6f\x82\xd9\x7f\xf4\xcf\x0c\x4d\xef\xd2\xed\xb9\xce\x7f\x36\x91\xbd\x2d\x6d\x70\x0a\xfa\xb1\x5b\xff\x99\xb0\x90\x46\x9b\xa7\xd4\xeb\xf5\xfb\xe3\xab\x91\x3b\x18\xbd\x35\x3c\x5e\x0c\x7e\xff\xe0\x9c\x18\x56\xa9\x04\x19\xf3\xbb\x16\x24\x44\xbc\x0a\x92\x14\x02\x9e\x32\x45\x84\xfc\x75\xc5\x66\x48\x05\x9c\x6a\x39\x0d\x46\x6f\xa7\xce\xe5\xa5\x95\x87\xe7\xc9\x25\x0b\xbc\x88\xa8\x60\xee\xf9\x2c\xf4\xfc\x30\x6c\xbc\xcc\x64\x71\xef\xd9\x13\xd9\x82\x43\x2d\xf9\x47\xfb\xcf\x96\x8a\xc8\x16\xe8\x85\xc6\x84\xe9\x41\x0f\x40\x62\x49\x9e\x30\x9f\xfa\xc2\xf9\xd4\x96\xf9\xf2\x6d\xac\xd5\xe4\x1d\x55\xc1\x1c\x1a\xe6\x84\xda\x53\xe2\x4b\x02\x45\xb3\x71\x82\x33\xed\xef\xc1\xf4\xd2\x45\x4f\x2b\xe9\x82\xc6\xbe\x68\x01\x5d\x2c\x48\x48\x7d\x45\xe2\x25\x84\x24\x26\x8a\x40\xa0\xc0\x9e\x56\xdc\xd9\x2d\x5b\xab\x8f\xff\x6a\x4f\x82\x98\x4b\xb4\x8d\xa7\x70\xa8\xd7\x84\xbb\x51\xec\xa2\xd6\xba\x64\x84\x5f\x6c\x92\xf8\xfc\x19\x5e\x6c\x8c\x32\xf3\xcd\x04\xf1\xcd\x21\xd8\xdf\x83\xc8\x8f\x63\x50\x73\xc1\xd3\xeb\xb9\xb6\xa6\xe5\x55\x1b\xeb\x78\x92\x4d\xd4\x10\x44\x59\xfb\x62\x96\xb9\x69\x5f\xe0\x17\x38\x30\xf3\x54\xdb\x18\x67\x3a\x1d\x4f\xbd\xa9\xe3\xb6\xe0\x6c\x72\xe1\x5d\x5c\x8d\xfa\xde\x1a\xbd\x16\x1a\x35\x23\xd5\x9c\xd7\x07\xe4\x4c\x10\x95\x0a\x06\x65\x2f\xd6\xad\xeb\xc6\x55\xdb\xc8\xf9\xd8\xad\x3f\xa0\x35\xd7\x66\x53\x05\x89\x17\xc5\xfe\xb5\x84\x3f\xf0\xb4\x80\xf1\x37\x61\x03\x7d\xb9\xeb\x0e\x1d\xcf\x19\x9d\x0f\x7a\x23\xef\x6c\xe0\x5e\x0c\x9c\xe1\x79\xb3\x5e\xf3\xbc\xf4\xf0\xb8\x26\x88\x3c\x3c\xe9\xb4\x20\xe4\x51\x84\x9f\x11\x65\x27\x87\x2d\x90\x4b\xfd\x21\xa4\xc2\x8f\x44\xce\xf1\xc3\x0f\x6e\xf0\x23\x15\xd7\xf8\x41\x02\x82\x1f\xc1\x9d\x38\x39\xc4\xa3\x1a\x97\xa6\x3d\x1b\xbc\xdd\x3a\x67\x36\x57\x36\xb7\x26\x91\x13\xb4\xe4\xed\x64\x76\x6a\xcb\x88\x65\x4b\x33\xa9\xe7\x94\xa4\xfe\x33\x11\x82\x8b\xda\x4f\xbd\xf0\xbf\x52\x69\x91\xed\x2f\xbe\x5c\xec\xe3\x61\xe0\x22\x24\xa2\x3d\x7f\x63\x39\x93\x3f\x65\x87\x61\xd3\x13\x6a\x37\x56\x70\x85\x88\x50\xd0\x1b\x6a\x91\x7b\x82\x20\x36\x26\x0d\xbb\xdf\xa5\x46\xeb\xfc\xb4\x1b\xd3\x2b\x04\xb5\x48\x0c\xb8\x60\x65\xbb\x35\x1c\xf7\x7b\xc3\x7a\x2d\x65\x68\x34\x6f\x8f\xfd\x30\x14\xd8\xd7\xd3\x5f\x4e\xe1\x8f\x07\xf4\x77\x48\x1a\x5f\x78\x88\x32\x1b\x2f\xb3\xf6\x16\xbc\xd4\xd3\xbc\x7a\x23\xf1\xb1\x59\xd5\xb5\xd0\xbe\xea\x1e\x3e\xd2\x3d\xcc\xba\xdb\x89\x9a\x2b\xc3\x6b\xec\x3f\x81\x05\xf1\x19\x1e\x38\x1e\x41\x5b\xf3\x6a\x0c\x09\x91\x39\x86\x17\xe4\xbf\x53\x2a\xb0\x8f\xe2\x06\x1e\xcf\xa8\x92\xf5\x1a\xec\x81\x2f\x10\xc2\xb6\xe0\x8e\x00\x67\xf1\xd2\x80\x69\xc5\x41\xde\xf9\x89\x06\xbd\xe8\x82\x34\xca\xa9\xa9\x45\x02\xa7\x90\x2d\xc3\xba\xa6\xe2\xe3\xaa\x35\x2c\xb7\x86\x59\xab\xde\x79\x83\xaa\x68\x02\x94\x5d\x0b\x22\xe5\x3e\xd1\x1f\x80\x47\x44\x4f\x84\xe7\xdc\x8e\x34\xc7\xe6\x65\x01\xc5\xe1\xc9\x2e\x37\x9e\xc2\xff\xac\x9a\xbb\xf5\xcc\x66\x95\x3a\x7d\x3e\x85\x62\x9f\x87\x4a\xfd\x0a\xd4\xb1\x57\x34\x19\x46\x81\x1a\xd5\xf8\x0a\x3c\x2f\xfd\x3b\xa8\x65\x42\x32\x08\x05\x01\x67\x52\xc1\x0e\x25\xcc\x7b\x7a\x5e\xfa\xfa\x08\x4a\xf8\x21\x47\x64\xa8\xa9\x1b\x3e\xd5\xea\xe6\xa6\x41\xd3\x0c\x64\xdb\x8e\xd2\x6f\x27\xfa\xf0\x96\x71\x90\x39\x8c\x35\x33\xad\x56\xb2\xa4\x03\xa7\x95\xae\xf6\x57\x28\x2a\x6a\x3b\xe9\xc0\x09\x14\x75\xb1\x9d\x74\xba\xdb\x19\xb1\xb4\xab\x38\x30\x27\xbb\x5e\x47\xb0\x1e\x45\x92\x28\x58\xa0\x49\x48\x38\xae\x5b\x71\x18\x4c\x6e\x8f\x51\xfc\x3b\x21\x70\x0e\x80\x8f\x2b\x10\xf0\x8e\x2d\xdf\x22\x43\xa4\x1e\x77\x3c\x1e\x45\x2d\x2b\x13\x49\x82\x40\xdd\xaf\xc3\xe3\xdd\xe8\xd8\x82\x60\x83\x2d\x31\xcc\xb1\x20\x1b\x4e\xa1\x14\x11\x74\x57\x67\xd6\xb0\x48\x25\x04\x82\xf8\x36\x60\xb7\x56\x0c\xb4\x59\x04\xca\xa8\xa2\x7e\x1c\x2f\x71\x6f\x22\xca\x42\xf0\xf5\x81\x4d\xb8\x22\x0c\x5b\xf2\xfe\x18\xc7\xb4\x73\x78\x65\xce\x3a\x09\x61\x46\x02\x3f\x95\x3a\xca\x06\x1b\xd2\x68\x02\x5c\x80\x0e\xb8\xc0\x2c\x45\xf9\x37\x04\x03\x5f\x12\x90\x90\xb0\x80\x00\xbf\x25\x02\x0a\xde\x0d\xc2\x14\x2d\x82\x9d\x3c\xa6\xc1\x32\x9b\x63\x81\x01\x51\x1b\x1b\x74\xe3\x39\x49\x50\xc8\x68\x89\x18\xee\x1d\xb1\x91\x86\x4d\x7d\x48\x9e\x0a\x24\x2f\x20\x24\x52\x51\x66\xc0\x29\x2a\x0c\x91\xc6\x16\x51\x09\xbe\x94\xe9\x82\x84\xb8\xe6\x99\x61\xdd\x76\xc8\x02\xfa\x80\x33\xe5\x53\x46\x04\xae\x98\x08\x12\x71\x81\x32\x86\x3d\xdd\x6a\xe7\xc8\xc6\x44\x1c\xf7\xd1\x86\x7b\x82\xc8\x04\x59\xba\x45\x88\x84\x9d\x0b\x6c\x18\x53\x58\x18\xc5\xb3\x70\x32\x0b\xf3\x10\xb8\x2a\x2e\x8c\xa4\x7c\x40\x2c\x13\x13\x88\x28\x89\xf1\x4d\xce\x80\x96\xab\x66\xad\x18\xa3\x82\xcf\xc2\x82\xfd\x01\x63\x93\x28\x0b\x69\x80\x22\xb8\x9b\xd3\x60\x5e\x62\x01\x49\x19\xda\x41\x2a\x04\x61\x2a\x5e\x16\x62\x50\xbd\xe5\xd6\x4e\x56\x22\xba\x92\xe9\x3b\x2d\x72\xb2\xc5\x3c\x96\xad\x63\x3d\x87\xa2\xb6\x13\x23\xf7\x6a\x1e\x0a\x0d\x49\x35\x36\x1b\x4c\x26\xd3\xb1\x3b\xf6\x06\xfd\x0f\x93\xdf\x8e\x4f\x2c\x70\x3f\xcc\x81\xba\xb5\x8c\x2b\x7c\x28\x6f\x30\xfa\xf1\x43\x03\x82\x8d\xc5\xc8\x0e\xde\x4b\x63\x3a\x0e\x0b\xc0\xcd\x42\xa9\xf3\xe9\x78\xe2\xe9\xa5\xfd\xd6\x1b\x0e\xce\xbd\x77\xe7\x53\x43\x72\xcd\xeb\x1c\x74\x0b\x2f\xc3\xd5\x4b\x1d\xc7\x64\x4b\x59\x26\x79\x60\xa7\x97\xa0\x59\xf7\xce\x9d\x4b\xd7\xbb\x1a\x4d\x9d\x5e\xff\xdd\xc9\x7a\xe3\xe4\xbd\xeb\xb9\xe3\xf1\xd9\xe0\xed\x46\x93\x3b\xf8\xe0\x78\xce\xef\x7d\xc7\x39\xdf\x1c\xd6\x9b\xf6\x3e\x4c\xa6\xe3\x33\xdd\xb2\xd5\x11\xd9\x43\xd9\x2d\x42\xe2\x75\x52\x4e\xff\xdd\xd8\xe4\x47\x4a\xb4\xb2\x35\x96\x7b\xfd\xfb\x95\x73\xe9\x3e\x85\x9c\xee\x58\x22\x98\xfb\x6f\x2d\xb5\x5a\x35\x32\xaf\xd5\x6a\x21\x89\xfc\x34\x56\x66\xec\xba\x95\x33\x79\x8e\xee\x1a\xc4\x7f\xa8\x9b\xff\x72\x96\x4a\x1a\xe4\xf6\x27\xeb\xea\xb3\x81\x9a\xf5\xff\x9f\xa2\x4b\xf0\x37\x38\x3c\x6a\xc1\x4b\x3d\xa0\x05\x47\x5f\xa0\x51\x48\x38\x65\x31\xbd\x21\xf1\xb2\xa1\xc7\xb7\xe5\x92\xe9\x28\xd9\x3c\xf9\xc1\x4d\xb3\xf9\xd8\xb2\x57\xc1\x63\x15\x41\x21\x95\x25\xb1\x41\xc3\x44\x39\x66\xeb\x34\x91\x8a\xe1\x11\x65\xdb\x86\xeb\xd0\xd0\x2c\xa4\x10\x71\x9f\x0b\x9e\xe4\x06\x0c\x8d\xa5\xc9\x74\x2e\xa8\xd4\x01\x5a\xaf\xff\x5e\x6f\xad\x0d\xbd\x73\x41\x55\xc6\x64\x25\xa1\x5d\x9d\x6b\xa1\xed\xef\x01\xca\x01\x8c\xf2\xfc\x0d\x8c\x56\x52\xa6\xb8\xf5\x6f\x79\x48\xbf\xfb\xf8\x17\x74\xba\x05\x9d\x95\xd0\x1e\x91\xd9\x76\x39\xe4\xaa\x56\x50\xd7\xfd\x3d\xe8\xfb\xec\xff\x29\x98\xfb\x2c\x8c\x09\x90\x7b\x45\x98\xce\xc5\xcf\x89\x1f\x12\x21\x61\x49\x94\xe1\x78\x6d\xde\xab\xd1\xfb\xd1\xf8\xe3\xc8\xd3\x6b\xb7\xb1\xde\xfe\x1e\x0c\x35\x0e\xd1\xa6\x3a\xf3\xc4\xb9\xd3\xcb\x7d\xa2\xf6\xca\x3a\xf1\x6e\x5c\x38\x03\x72\x4f\xd1\xed\xd8\xf4\xa3\xcd\xd3\x6f\x10\x68\xdb\xd1\x15\x4d\xe8\x8a\x70\x0c\x67\xe8\x39\x50\xaa\x59\x17\xe6\xe3\xee\x87\xe4\x7e\xe5\xad\x32\x57\xb1\x35\xdb\x36\x1c\x8f\xdf\x5f\x4d\xbc\xa9\xf3\x5b\x0b\x1a\xb3\x24\xf2\x98\xe2\x73\xd9\x28\x9a\x85\xa6\xc9\xb2\x35\xe1\x73\x1d\xb1\x2b\x00\x6c\xf4\xd3\xb2\x6b\xb6\xd6\x1d\x06\x0e\xfc\x7b\x13\x3e\x43\xd1\x04\xea\x60\x67\x15\xc9\x17\x93\x9a\x06\xcc\x69\x34\x69\x12\x96\x59\xb2\x12\x91\x58\x8e\xbe\x9a\x4d\x78\x91\x41\x2d\x63\x32\x90\x9c\x3d\x2a\x9a\xe8\xe9\x5a\x80\x6e\x53\x8e\xe5\x33\xb9\x25\xc2\xb0\x56\xb9\x99\x9b\x0d\x03\xeb\x8a\xc6\x3a\x4f\x8a\x14\x5a\x27\xc3\x4f\x5d\x7b\x7e\xae\xb9\xe2\x08\x20\x36\x54\xc5\x64\x63\x28\x43\x84\x71\xe7\x8b\xb0\x20\x53\xed\xce\x2b\x63\x5a\x13\xbd\x56\x42\xee\x92\x08\xbf\xa7\xf8\xbe\x54\x72\xa3\xab\xe1\xb0\xd9\xad\xca\x61\x3e\x92\x65\xf4\xfa\x43\xa7\x37\xcd\xa3\x07\xdc\xcb\x11\xd7\xfb\x48\x09\x02\x35\x1d\xba\xda\xf4\xa7\x8e\x26\x66\x04\x48\x4c\xaf\xe9\x2c\x36\x77\x47\x1a\x5e\xe3\x69\xf3\xa1\xef\x16\xd2\x61\xa8\x09\x2b\x55\x19\x39\x1f\xd1\xd2\x5b\x83\xf2\x62\xcd\xa2\x34\xeb\x99\xa4\x33\x8b\xd0\xef\x8d\xdc\xdc\xdc\xd4\x79\x8a\x06\x66\xab\x78\x7e\x73\xa6\xe7\x83\xbe\xab\x53\x4a\x68\xda\xe0\x57\x78\x85\x5f\x4f\xf0\x05\xc6\x17\x95\x89\xe0\x2c\xb1\xbd\x25\x09\x6d\xa5\x80\x66\x4a\xa7\xdf\xab\x03\xda\x72\xc2\xa4\xb3\x2b\x61\xd2\xf9\xfa\x84\x89\xe7\xcd\xc8\xeb\xa3\x62\xaa\xa4\x18\x41\x16\x72\x04\xe5\xd6\xb0\xdc\x1a\x66\xad\x96\xcc\xff\x49\x9e\x43\xeb\xc4\xda\x02\x9e\x73\x1f\x26\xf7\xd1\xf9\xa6\xb9\x8f\x0d\x7d\xfa\x1e\xb9\x8f\x1d\x89\x0f\xad\xa2\x56\xc5\x1e\x4f\x7b\xac\xe5\x3c\x1e\x49\x78\x7c\x65\xb6\xa3\x63\x91\xc7\xd3\x93\x1e\x9d\xea\xa4\xc7\xb6\xbd\xde\x91\xf4\x78\xce\x78\x3c\x67\x3c\x9e\x33\x1e\x5f\x99\xf1\xf8\xca\x7c\xc7\x3f\x38\xd9\xb1\x23\xd5\x51\xcc\x66\xac\xe7\x33\x4c\x36\xc3\x71\x9d\xe9\x37\xc8\x68\xe8\x04\xc4\xee\x74\x86\xee\xf2\x08\x85\xbf\x54\xea\xe2\x39\x6f\xf1\xcf\x95\xb7\x78\x4e\x5a\xec\x4c\x5a\xfc\x78\xc9\x86\x6d\xd0\x76\x83\xb1\x4e\x1e\xbd\x1e\xe8\xc0\xf5\x39\x53\xb1\x3d\x53\x51\x11\x4c\xfe\x15\x32\x15\xb9\xe4\x2c\x72\x7d\xce\x36\x6c\xc9\x36\xfc\xd9\x5b\x5c\x13\x05\x7c\xbb\x5b\xdc\xa7\x46\x2e\x68\x51\xf5\xd4\x2b\x1d\xe7\x82\x5e\x53\xe6\xc7\x6b\x4a\xbe\x5e\xf3\x98\x95\x36\xe2\xf9\x6c\xef\xac\x27\x6c\xd5\x6b\x0f\xb6\x36\xb2\x54\xda\xa7\x41\x9a\x26\xd3\x5e\xaf\x28\xdc\xb2\xf1\xdd\xac\x7f\xa1\x78\xb0\xd4\x7b\x55\x51\xb8\x0d\x1c\xe7\x66\x67\xed\xc4\x9c\x9e\x16\xdd\x22\x7c\xce\xce\xdc\xf6\x6e\x6e\x7f\x62\xad\xd3\xfe\x1e\x4c\x89\xe4\xf1\x2d\x81\x61\xc7\x86\x48\x36\x1e\x5b\xf8\x4b\x88\x7c\x1a\xdb\x18\x6a\x15\x3f\xf9\x92\x33\xd9\x86\x0f\xfe\x12\x29\x60\x54\x96\x28\xce\x74\xb8\x67\x55\xcb\x37\xbb\xa5\xb3\x2e\xc0\xd2\xc5\x8c\x08\xa4\x20\x88\x91\x0b\x68\xdf\x2e\xfc\x28\xa2\x01\x28\xde\x36\x64\x34\x02\x28\xed\x72\xdc\xf1\x6c\x72\xc5\x33\x93\x37\x56\xa7\x3d\x77\xd8\x6b\x30\xbf\x9b\xd9\xdc\xc1\xa5\xe7\x4c\xa7\x8d\x15\xc1\x66\x09\x86\x15\xab\x32\xeb\x5b\x0b\xcc\x86\x1d\x6f\x32\x1e\x0e\xfa\x9f\x5a\x85\x01\xad\xa2\x50\xcc\xe8\x1c\xf1\xd8\x1d\xd1\xb8\x07\x57\x99\x61\x1f\x1d\x26\xe9\xba\x4e\xdc\x88\x6b\x9e\x15\xa2\x9b\x7d\x42\x8b\xc3\xf8\x9d\x1d\xec\x4b\xb8\x23\x10\x72\x60\x5c\x81\x4c\x13\xbd\x1b\xd9\xde\xa1\x4e\x23\x52\xc0\xa8\x0c\x09\x90\x7b\x0c\x0c\x6d\x20\xa8\x87\xa3\xdc\x66\x5a\x62\x7e\x92\xc4\x4b\x90\x24\x48\x05\x55\x4b\x10\x69\x4c\x64\x71\xb3\x71\x9b\x0a\xfb\xb3\x81\x52\xcd\xd2\xf3\x8a\x39\xab\xef\x79\xb9\x64\x56\x5a\x98\xbf\xd7\xd0\x0a\x4e\xf3\xba\x48\x74\x46\xab\x2a\xcc\x7f\x31\xcd\x25\x3f\xa8\xe2\x3a\xff\xa2\x7a\xab\xb6\xe8\xad\xaa\xd6\xdb\xdc\xbe\x97\xe4\x5a\xde\xfc\xe2\xd6\xbf\x2e\xf9\x6e\xe3\xe3\xcf\xbf\x17\x3e\x6a\x65\x54\x36\x58\x2e\x55\xd5\xb7\x37\xa0\xc0\xb6\x3a\xb9\xcd\xa5\x1c\x65\x28\x4b\xcb\xc1\xfc\xdb\xe2\xea\x4a\x88\x7b\xe1\x27\x5e\x9a\x84\xfe\x66\x79\x6f\x0b\x4c\x45\x75\x0b\x0e\xf2\x98\x6b\x2d\xf4\x31\x73\x7b\x17\xbd\xc1\x10\x61\x70\xd1\xe7\xfb\x4c\x27\x11\x6e\x8f\xad\x37\xd7\x87\x34\xd6\x68\x40\x08\x2e\x64\xd1\xf1\x97\x71\x08\x0d\x16\x89\xfd\x6a\x21\x40\x6e\x67\x4a\xb9\x21\x5c\x69\x3b\x4f\xd5\xe8\xa7\xb0\xf4\x94\xa5\x9f\xca\x39\x94\x75\x50\x6f\x20\x44\xa5\x36\x1d\x54\xfc\x32\x26\xcb\x91\xaf\x17\x91\xae\xb8\x6e\x97\xcb\x4e\xf3\x52\x52\x1d\x20\xec\x18\x16\x3e\xb1\x5c\xb5\x30\x44\x56\x17\xc4\xe6\xd7\x39\x5b\xb1\x6b\xae\xff\x07\x2d\x68\x14\x08\xae\x2b\x71\xa1\x29\x07\xfa\xab\xec\xc1\x47\x02\x81\xfe\x5d\x58\xc8\xc1\x07\x83\xfa\xb5\x01\x09\xe6\x24\xb8\x01\x1a\xa1\x16\x0c\x5f\x5b\x0d\xf0\x63\x41\xfc\x70\x69\x22\x5c\x99\xa3\xf5\x4a\x05\x2c\x2c\x72\x43\x0d\xb5\x4e\xec\xef\xc1\x44\x90\x5b\xca\x53\x74\x27\x09\x18\x0a\x20\xd3\x20\x20\x24\x24\xa6\xcc\xd6\x32\x67\x6a\xf4\xa9\xaa\x6b\x33\x36\x4b\x15\xb6\x2d\xf4\x4f\x20\xb5\x49\x8c\x63\xd0\x25\xd3\x31\xd1\x3f\xde\xd3\x60\x93\xa7\xaa\x5d\xcf\x0c\xda\x6e\xad\xd7\xb6\x68\xcb\x4f\x4c\xca\xf6\xc8\xd2\x39\xa8\xb8\x19\xda\x06\xdb\xbf\xdd\x3d\xc4\x33\x6c\xff\x8e\xb0\xbd\xfa\xe0\xbd\x75\x46\xce\x74\xd0\x6f\x15\xd8\xc3\xad\xf5\x76\x00\x8e\x1f\x1d\x45\x55\xe1\xff\xa7\xaf\xee\xbb\xc6\x01\xcf\x28\xf9\xdb\xa0\xe4\x1f\x4a\xbc\x4e\xa5\x74\xbf\x3d\x96\xdc\xac\xa7\xb1\x49\xeb\xea\x9a\x1a\x5d\x54\x73\xdc\x69\xe6\x33\x9b\x5f\x02\x9e\xc2\xca\xfc\x18\xce\xb7\x38\xeb\x3f\x8b\x56\xbf\x11\x5c\xfd\x0a\xbc\xfa\x68\x02\xf8\x87\xc2\xab\xc5\xdf\xa2\x5a\xa8\xa6\xcf\xa5\xbe\x67\xac\xc2\x8f\x5d\xdd\x98\x97\x38\xec\x00\x88\xb5\x72\x0d\x8e\x2d\x6e\xa8\x15\x1e\x4b\x7e\x2a\x2b\xc4\x31\x69\x64\x3b\x85\x2d\x89\xc8\x88\x6d\xd6\xfb\xd4\xc2\xea\x4a\x9f\x1d\x3f\x41\x5c\x2b\x11\x5a\xe7\x60\x95\xc9\x5e\xab\x16\xda\x64\xd5\x14\x8a\xa1\x09\xfb\x48\xc0\x17\x04\x11\x60\x42\xd9\x35\x68\x27\x6c\x2e\x79\xe6\xc4\xa2\x07\x20\x2c\xb4\xa9\x4b\x7b\x69\xe4\x83\x24\xe2\x96\x06\x5a\x05\x60\x0f\x24\x51\x80\xf8\x11\x02\xce\x18\x6a\xcf\x8d\xbd\x23\xc2\x28\xd6\xdc\x82\x24\xa6\x62\x81\x30\x99\x0a\x62\x83\x59\x41\x6e\xf1\xb0\x59\x70\xa7\xef\xec\xc1\x57\x8a\x2c\x12\x65\xcb\x93\x04\x4f\x15\x59\xbf\x95\xcf\xaf\xc3\x4d\x04\x7d\x47\xe3\xd8\x90\xc0\xe0\xd8\x30\xaa\x97\x61\x17\x61\xfe\x62\x86\x29\x01\x68\xaf\x6e\xad\x36\x71\x86\xb5\xeb\x3b\x6e\xc0\xb7\x56\x77\xed\xfc\xe1\xe8\x56\x69\xc8\xdb\xc0\xcb\x44\xb7\xba\x85\xd8\x2a\xe5\x55\xf7\x5c\x80\xda\x70\x3e\x1e\x2a\x3c\xc5\xf8\x7c\xa3\xbb\x84\xaf\xb5\x23\x4f\x35\x24\x5f\x61\x49\x1e\x31\x25\x5b\xad\x40\xf9\xbc\x97\x4f\xf9\x9a\x90\xd6\x4c\x44\x26\xea\x70\x4d\x5c\x6b\xaa\x95\x99\xa5\x87\xaa\xf0\xfb\x69\xc1\x77\x67\x6b\xf0\xbd\x75\x59\xed\x4d\xeb\xd6\xca\xd7\xd5\xae\xb2\x4a\x26\x5c\xaf\x28\x5b\x6c\xad\x96\xf6\x0f\x8d\xf4\xff\x9a\xd1\xf2\x13\x9c\xe0\x97\xc7\xae\x5a\xd0\xb0\xbf\x07\x2f\x72\xcd\x78\xf4\x26\xea\x8b\xfe\xa4\x46\xe9\x8f\x69\xd8\x72\xc6\xaa\x3f\xa9\xf1\xc8\x1f\xd3\xf8\xa2\x80\xfb\x5b\xff\xda\xf1\x4f\x16\xfe\x7d\x05\xef\x3f\x4c\xd1\xe2\x57\x24\x3a\xbe\xff\xfd\xe4\x3f\x45\x36\xa6\xc8\x64\xfe\x67\x40\xcc\xe7\xff\x06\x00\x00\xff\xff\x4d\x75\x99\x5d\xd0\x4b\x00\x00")
+var _bpfLibConntrackH = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5c\x7d\x73\xdb\x36\x93\xff\x9b\xfc\x14\x9b\x66\x26\x67\xb9\x8c\xfc\x12\x9f\xef\x19\xab\x4e\x47\x96\xe9\x44\x13\x45\xd2\xc9\x54\xd3\xcc\xcd\x0d\x86\x22\x21\x0b\x67\x0a\xe0\x01\xa0\x6d\x4d\x93\xfb\xec\x37\x0b\x82\x2f\x7a\xb3\x9d\x34\x4f\x9b\xf6\x71\x67\x3a\x32\x09\x60\xb1\xc0\xbe\xe0\xb7\x8b\x65\xf6\x76\x5d\xd8\x05\xe8\x88\x74\x21\xd9\xd5\x4c\xc3\x4e\xa7\x01\x87\xfb\x07\xc7\x2f\x0f\xf7\x0f\xfe\x03\xda\x99\x9e\x09\xa9\x40\x4c\xa1\xc3\x12\x96\xcd\x5d\xc8\x07\x04\x33\xa6\x20\x95\xe2\x4a\x86\x73\x60\x0a\xa6\x92\x52\x50\x62\xaa\x6f\x43\x49\x5b\xb0\x10\x19\x44\x21\x07\x49\x63\xa6\xb4\x64\x93\x4c\x53\x60\x1a\x42\x1e\xef\x09\x09\x73\x11\xb3\xe9\xc2\x10\x62\x1a\x32\x1e\x53\x09\x7a\x46\x41\x53\x39\x37\x93\xe1\xc3\x9b\xfe\x18\xde\x50\x4e\x65\x98\xc0\x30\x9b\x24\x2c\x82\x1e\x8b\x28\x57\x14\x42\x05\x29\xbe\x51\x33\x1a\xc3\x24\x27\x84\x43\x2e\x90\x8b\x4b\xcb\x05\x5c\x88\x8c\xc7\xa1\x66\x82\xb7\x80\x32\x3d\xa3\x12\x6e\xa8\x54\x4c\x70\x38\x2c\x26\xb1\x14\x3d\x10\xd2\x50\xd9\x09\x35\x32\x2f\x41\xa4\x38\xb0\x01\x21\x5f\x40\x12\xea\x6a\x6c\x73\xdb\x16\x54\x2b\x8d\x81\x71\xb3\x9e\x99\x48\x29\xe8\x59\xa8\x71\xed\xb7\x2c\x49\x60\x42\x21\x53\x74\x9a\x25\x9e\x99\x6e\x92\x69\xf8\xd0\x0d\xde\x0e\xc6\x01\xb4\xfb\x1f\xe1\x43\x7b\x34\x6a\xf7\x83\x8f\x2d\xb8\x65\x7a\x26\x32\x0d\xf4\x86\xe6\xb4\xd8\x3c\x4d\x18\x8d\xe1\x36\x94\x32\xe4\x7a\x01\x62\x6a\x48\xbc\xf7\x47\x9d\xb7\xed\x7e\xd0\x3e\xeb\xf6\xba\xc1\x47\x10\x12\x2e\xba\x41\xdf\xbf\xbc\x84\x8b\xc1\x08\xda\x30\x6c\x8f\x82\x6e\x67\xdc\x6b\x8f\x60\x38\x1e\x0d\x07\x97\x7e\x13\xe0\x92\x22\x63\xd4\x50\xb8\x67\xa3\xa7\x46\x58\x92\x42\x4c\x75\xc8\x12\x55\x2e\xfe\xa3\xc8\x40\xcd\x44\x96\xc4\x30\x0b\x6f\x28\x48\x1a\x51\x76\x43\x63\x08\x21\x12\xe9\xe2\x61\x19\x1a\x2a\x61\x22\xf8\x95\x59\x2a\xe8\xda\x6e\xb6\x80\x4d\x81\x0b\xed\xc1\xad\x64\x9a\x82\x16\xeb\xd2\x35\xe3\x2b\x09\x7b\xd0\xe5\x51\xd3\x83\x7f\x3f\x80\x0b\x19\xf2\xeb\x84\x71\xb8\xd4\x1e\x5c\xb0\xa9\x9e\xc1\x45\x22\x84\xf4\xe0\x4c\x28\x8d\x5d\xdf\xb7\x01\xf6\x0f\x0f\x0e\xf6\x5f\x1e\xbc\xda\x3f\x00\x18\x5f\xb6\x5d\xd8\xdd\x73\x9f\xb3\x29\x8f\xe9\x14\x08\xe9\x75\xcf\x48\x67\xd0\xef\x07\xa3\x76\xe7\x1d\x79\x4b\xdc\xe7\x31\x9d\x32\x4e\x37\x35\xb9\xcf\x19\x8f\x92\x2c\xa6\xf0\x53\xc2\x78\x76\xb7\xc7\xa2\x79\x7a\x73\xdc\x9c\xbd\xde\xd8\x82\xef\xab\x86\x1f\x22\x31\x9f\x0b\xde\x9c\xfd\x50\x7b\xc7\xcc\xf0\xfa\x9b\x78\x72\xb5\xfc\x22\x39\xc2\xe7\x92\xad\x4e\x40\xce\xfd\x8b\xf6\xb8\x17\x90\x5e\xf7\xc2\xef\xbe\xf7\xe1\xd5\xf1\xbe\xeb\x52\x9e\xcd\xe1\x37\xd7\xe9\x04\xa4\xef\x7f\xf0\xcc\x1f\xfe\x65\xd0\x3e\xeb\x75\x2f\xdf\xfa\xe7\xf9\x8b\x91\x3f\xec\x7d\x2c\xfe\xec\xb5\x03\x7c\xff\xb9\xe5\xe2\x76\xe0\x6e\x94\x8b\xad\xa6\x0b\xc6\xc3\x9e\x4f\x2e\xc8\x60\x1c\x38\xce\xbe\xb3\xb7\x0b\x83\x4c\x5f\x09\xc6\xaf\x60\x9a\x88\x5b\xb3\x97\x2b\x5d\xbb\x7d\xc7\x39\xc0\x9e\x5d\x1e\x89\xf9\x7d\x3d\x2d\x0f\x8e\x73\x88\xdd\x2f\x90\x9e\xa4\xa9\xa4\x8a\x72\xad\x40\x52\xb4\xc6\x18\xd2\x30\xba\xa6\x5a\x21\x81\x72\x95\xed\x4e\xd0\x1d\xf4\xc9\xb8\x7f\x39\xf4\x3b\x5e\xf9\xdc\x19\xf9\xed\xc0\xaf\x3d\xf7\x06\x97\xb5\xc7\x73\xbf\xe7\x63\x33\x2e\x59\xe9\x50\xb3\x08\x18\x4f\x90\x23\xc6\x35\x10\x92\x3f\x10\x02\x84\x44\x9a\x24\x42\x5c\x67\xe9\xce\x8d\x60\x31\xec\xce\xc3\xd4\x03\xa5\x65\x16\x61\x47\x75\x4d\x26\xd9\x74\x0a\xbb\xea\x7a\xe2\xb9\x0e\xfe\x07\x79\x3f\x9d\xa5\x09\xf5\x0c\xbd\x30\xca\xd5\x15\xff\x8e\x99\x2c\xfa\x59\x22\x91\x26\xc8\x02\x85\xdd\xe2\xaf\x86\xfb\x9b\xeb\x54\xad\x94\x6b\xb9\x80\x5d\xf3\xd3\x72\x1d\xa4\x22\xa9\x6e\xb9\xae\xc3\xa6\xb0\xb3\x63\xde\xc3\x29\xcc\xc3\xd4\x72\x4a\x68\x42\xe7\x3b\x86\x51\xc3\x45\xa3\xd1\x40\x85\x70\x22\xe3\xce\x89\x96\x61\x44\x77\x90\x61\x38\x3f\x7b\x43\x3a\x01\x79\xdf\x0e\x3a\x6f\x3d\x30\x94\x5e\xbe\x4e\xd8\x94\x6a\x36\xa7\x86\x4f\xfb\x2e\x95\xe2\x6e\x41\x52\x21\x35\xfc\xf4\x13\x1c\x1c\xc3\xa7\xa2\xb7\xa4\x37\x84\x87\x9a\x30\x1e\xd3\xbb\x46\xcb\x75\x9c\x15\x32\x70\xba\x41\x55\xb1\x1f\x72\x5f\x2e\xd9\x30\xe8\x14\x8f\x2b\x64\xe1\x74\xe3\x6c\xad\xe5\x21\x89\x10\xe9\x24\x8c\xae\xab\xde\xc9\x84\x14\x2f\x57\xfa\xd6\xd6\x73\x0a\x6b\x6b\xc4\xce\x9f\x4b\x63\xe8\xfd\xda\x21\xfd\x76\x70\x74\xec\x3a\xa8\x9d\xf9\x19\x60\x54\x11\x38\xa5\xb1\x02\x1e\xea\xa3\x63\xd0\x32\xe4\x2a\x31\x67\x0f\x6a\x68\xbe\x3e\x4b\x3a\xef\xf1\xe2\x05\x3c\x53\xd7\x93\x97\xaf\xa3\xc9\x7f\x75\xce\x72\x9a\xe4\x32\x68\x07\xfe\x7f\x37\x90\xbd\x2d\x6d\x70\x0a\xa6\x6b\xcb\x7d\x4e\x79\xcc\xa6\xeb\x56\x4a\xda\x9d\xce\x60\xdc\x0f\xba\xfd\x37\x39\x8f\x17\xdd\x5f\xdf\xfb\x27\x39\xab\x4c\x81\x4a\xc4\xad\x07\x29\x95\x2f\xa3\x34\x83\x48\x64\x5c\x53\xa9\x7e\xae\xd8\x8c\x99\x84\x53\x23\xa7\x6e\xff\xcd\xc8\xbf\xbc\xcc\x15\xc6\x21\x44\x2d\x78\x44\xa6\x54\x47\x33\x12\xf2\x98\x84\x71\xbc\xf3\xc2\x2e\x4a\xde\x11\x6b\x91\x1e\x1c\x34\x5a\x8f\xe9\x3f\x59\x68\xaa\x3c\x30\x0b\x4d\x28\x37\x83\x3e\x03\x4d\x14\x7d\xc4\x7c\xfa\x0b\xe7\xd3\x5b\xe6\x2b\xb7\xd1\x71\xd4\x2d\xd3\xd1\x0c\x76\x72\x0b\xb5\x56\x12\x2a\x0a\x75\xb7\x71\x82\x33\xed\xed\xc2\xe8\x32\xc0\x93\x56\xb1\x39\x4b\x42\xe9\x01\x9b\xcf\x69\xcc\x42\x4d\x93\x05\xc4\x34\xa1\x9a\x42\xa4\xc1\x5a\x2b\xee\xec\x96\xad\xc5\x96\xc2\x48\xe4\x1d\x89\x12\xa1\xd0\x37\x9e\xc2\x01\xb2\xe7\xe0\x6e\xd4\xbb\xe8\x95\x2e\x05\xe1\x67\xeb\x24\x3e\x7d\x82\x67\x6b\xa3\x8c\x6a\x39\x13\x49\xc3\xeb\x96\x5d\xc9\x34\x4c\x12\xd0\x33\x29\xb2\xab\x19\xb2\xba\xb2\xea\xdc\x3b\x9e\x14\x13\xed\x48\xaa\xad\x7f\xc9\x97\xb9\xee\x5f\xe0\x27\xd8\xcf\xe7\xd9\xec\x63\xfc\xd1\x68\x30\x22\x23\x3f\xf0\xe0\x6c\x78\x41\x2e\xc6\xfd\x0e\x59\xa1\xe7\x81\xa4\x3a\x97\x6a\xc9\xeb\x67\xd7\x75\x1c\x49\x75\x26\x39\x2c\x9f\x62\x2d\xd7\x34\x56\x6d\x7d\xff\x43\xcb\xfd\x8c\xde\xdc\xb8\x4d\x1d\xa5\x64\x9a\x84\x57\x0a\x7e\x43\x6b\x81\xfc\x64\x8a\x77\xf0\x2c\x0f\x82\x9e\x4f\xfc\xfe\x79\xb7\xdd\x27\x67\xdd\xe0\xa2\xeb\xf7\xce\x1b\xae\x43\x48\x76\x70\xec\x48\xaa\x0e\x4e\x8e\x3c\x88\xc5\x74\x8a\xbf\x53\xc6\x4f\x0e\x3c\x50\x0b\xf3\x23\x95\xc6\x9f\x54\xcd\xf0\x27\x8c\xae\xf1\x27\x93\x57\xf8\x43\x23\x8a\x3f\xd1\xad\x3c\x39\x40\x53\x4d\x96\xa6\x3d\xeb\xbe\xd9\x3a\x67\x31\x57\x31\xb7\x21\x51\x12\xb4\xe4\xed\x64\x76\x6a\xcb\x88\x65\xcb\x30\x69\xe6\x54\xd4\x7d\x4e\xa5\x14\xd2\xf9\xa1\x1d\xff\x4f\xa6\x2c\xb2\xfd\x29\x54\xf3\x3d\x34\x06\x21\x63\x2a\x9b\xb3\xd7\x96\x33\xf5\x43\x61\x0c\xeb\x27\xa1\x39\xc6\x6a\x47\x21\x22\x14\x3c\x0d\xcd\x91\x42\x24\x45\x5c\x4d\x77\xec\x7e\x2f\x35\xda\xc3\xcf\x1c\x63\x66\x57\x41\xcf\xd3\x1c\x5c\xf0\x65\xbf\xd5\x1b\x74\xda\x3d\xd7\xc9\x38\x3a\xcd\x9b\xe3\x30\x8e\x25\xf6\x45\x33\x96\x70\x0a\xbf\x7d\xc6\xf3\x0e\x49\xe3\x0b\x82\x28\x73\xe7\x45\xd1\xee\xc1\x0b\x33\xcd\xcb\xd7\x0a\x1f\x1b\x9b\xba\xd6\xda\xab\xee\xf1\x03\xdd\xe3\xa2\xbb\x9d\xa8\x51\x39\x5e\x34\x9e\x60\x46\x61\x4e\x43\x8e\x06\x27\xa6\xd0\xc4\xde\x90\x3b\x12\xaa\x4a\x0c\x2f\xe9\xff\x66\x4c\x62\x1f\x2d\x72\x78\x3c\x61\x5a\xb9\x0e\xec\x42\x28\x11\xc2\x7a\x70\x4b\x41\xf0\x64\x91\x83\x69\x2d\x40\xdd\x86\xa9\x01\xbd\x78\x2c\x19\x94\xe3\xe8\x79\x0a\xa7\x60\xf9\x52\xf6\x68\xaa\x3f\x56\xad\xf1\x72\x6b\x5c\xb4\x9a\x9d\x47\xbe\x2f\x12\x96\x02\xe3\x57\x92\x2a\xb5\x47\xcd\x0f\xa0\x89\x98\x89\xd0\xa1\xd8\x91\xf8\x4e\xc1\x8b\x1a\x8a\x43\xcb\x5e\x6e\x3c\x85\xff\xab\x9a\x5b\x6e\xe1\xb3\x96\x3a\x7d\x3a\xad\x91\xb0\xb6\xb9\xa6\x5f\x91\x3e\x26\x75\x97\x41\x0c\x89\x9d\xcd\xf8\x0a\x08\xc9\xfe\x01\x7a\x91\xd2\x02\x42\x41\x24\xb8\xd2\x70\x8f\x12\x96\x3d\x09\xc9\x5e\x1d\xc2\x12\x7e\x28\x11\x19\x6a\xea\xda\x99\x6a\x75\x73\xdd\xa1\x19\x06\x8a\x6d\x47\xe9\x37\xd3\x23\x6f\x99\x72\xa3\x30\x46\xb4\xf0\x57\x87\x80\xbd\x48\x7a\x04\xa7\x1b\x8f\xda\x9f\x0b\x62\xca\x52\x83\x93\xe2\x4d\x6c\xdf\xb4\xb6\x33\x62\x69\x6f\xe2\xc0\x28\xed\x67\xd7\x45\xb0\x3e\x9d\x2a\xaa\x61\x8e\x2e\x21\x15\xb8\x6e\x2d\xa0\x3b\xbc\x39\x46\xf1\xdf\x0b\x81\x4b\x00\x7c\xbc\x01\x01\xdf\xb3\xe5\x5b\x64\x88\xd4\x93\x23\x22\xa6\x53\x23\xcf\x57\x87\xa0\x68\x14\xe9\xbb\x55\x78\x6c\x87\x6f\x41\xc7\x16\x04\xe7\xd8\x12\xc3\x1c\x0b\xb2\xe1\xb4\x38\xc4\xf2\x88\xc0\xea\x3e\xda\x6c\xce\x22\x53\x10\x49\x1a\xda\x80\xdd\x7a\x31\x30\x6e\x11\x18\x67\x9a\x85\x49\xb2\xc0\xe8\x73\xca\x78\x0c\xa1\x31\xd8\x54\x68\xca\xb1\xa5\xec\x8f\x71\x4c\xb3\x84\x57\xb9\xad\x63\x5a\x82\x46\x61\xa6\x4c\x94\x0d\x36\xa4\x31\x04\x84\x04\x13\x70\x01\x6e\x34\x05\x1d\x5e\x53\x0c\x7c\x69\x44\x63\xca\x23\x0a\xe2\x86\x4a\xa8\x9d\x6e\x10\x67\xe8\x11\xec\xe4\x09\x8b\x16\xc5\x1c\x73\x0c\x88\x9a\xd8\x80\xff\xc3\x39\x4d\xd1\x7d\xa3\x27\xe2\x10\x33\x49\x6d\xa4\x61\x53\x1f\x4a\x64\x12\xc9\x4b\x88\xa9\xd2\x8c\xe7\xe0\x14\x15\x86\xaa\xdc\x17\x31\x05\xa1\x52\xd9\x9c\xc6\xb8\xe6\x49\xce\xba\xed\x50\x04\xf4\x91\xe0\x3a\x64\x9c\x4a\x5c\x31\x95\x74\x2a\x24\x1a\x20\xec\x9a\xce\x76\x8e\x62\x0c\x66\x0f\x58\x11\xee\x49\xaa\x52\x64\xe9\x06\x21\x12\x76\xae\xb1\x61\x08\xd4\x47\x89\x22\x9c\xb4\x20\x0f\xf3\x2b\x4a\x0b\x99\x4b\x2a\x04\xc4\x32\x09\x85\x29\xa3\x09\xbe\x29\x19\x30\x72\x35\xac\x95\xfe\x06\x53\x2b\x21\x8f\x6b\xfe\xc7\x78\x3a\x05\x8c\xc7\x2c\x42\x11\xdc\xce\x58\x34\x5b\x62\x01\xd9\xcb\x69\x47\x99\x94\x94\xeb\x64\x51\x8b\x41\xcd\x96\xef\xb9\xdb\x11\x9d\xb5\xd7\x7c\x9a\xca\xf3\x0d\xc6\xc1\x16\xf7\xb8\xec\x1d\xdd\x12\x8a\xda\x4e\x9c\xde\xe9\x59\x2c\x0d\x24\x35\xd8\xac\x3b\x1c\x8e\x06\xc1\x80\x74\x3b\xef\x87\xbf\x1c\x9f\x58\xe0\x7e\x50\x02\x75\xeb\x19\x2b\x7c\xa8\xae\x31\xfa\x09\xe3\x1c\x04\xe7\x1e\xa3\x30\xbc\x17\xb9\xeb\x38\xa8\x01\x37\x0b\xa5\xce\x47\x83\x21\x31\x4b\xfb\xa5\xdd\xeb\x9e\x93\xb7\xe7\xa3\x9c\xe4\xca\xa9\xb3\xdf\xaa\xbd\x2c\x0e\x9b\xfd\xbc\x6b\xb9\x94\x45\x5a\x06\x76\x66\x09\x86\x75\x72\xee\x5f\x06\x64\xdc\x1f\xf9\xed\xce\xdb\x93\xd5\xc6\xe1\xbb\x80\x04\x83\xc1\x59\xf7\xcd\x5a\x53\xd0\x7d\xef\x13\xff\xd7\x8e\xef\x9f\xaf\x0f\x6b\x8f\xda\xef\x87\xa3\xc1\x99\x69\xd9\x7a\x10\x59\xa3\x6c\xd5\x21\xf1\x2a\x29\xbf\xf3\x76\x40\x8c\xb9\x2e\xd1\x2a\xd6\xb8\xdc\xeb\x3f\xc7\xfe\x65\xf0\x18\x72\xa6\xe3\x12\xc1\xf2\xfc\x36\x52\x73\x36\x23\x73\xc7\x71\x62\x3a\x0d\xb3\x44\xe7\x63\x57\xbd\x5c\x9e\xe7\x58\x62\xc0\xc1\x10\x27\xff\xbf\x64\x69\x49\x83\x82\xce\x70\x55\x7d\xd6\x50\xb3\xd9\xb9\xc7\xe8\x12\xfc\x08\x07\x87\x1e\xbc\x30\x03\x3c\x38\xfc\x02\x8d\x42\xc2\x19\x4f\xd8\x35\x4d\x16\x3b\x66\x7c\x53\x2d\xb8\x89\x92\xf3\xa7\x30\xba\x6e\x34\x1e\x5a\x76\x15\x3c\x6e\x22\x28\x95\xb6\x24\xd6\x68\xe4\x51\x8e\xa1\x61\x0c\x14\x36\x0c\x9f\x32\xbe\x6d\xb8\x09\x0d\xf3\x1d\xaa\x45\xdc\xe7\x52\xa4\xa5\x03\x9b\x51\x49\x0d\x20\x84\x39\x53\xe8\xbe\xa0\xdd\x79\x67\x3c\x91\x0d\xbd\x4b\x41\x6d\x8c\xc9\x96\x84\x36\x3e\x37\x42\xdb\xdb\x05\x94\x03\xe4\xca\xf3\x23\xe4\x5a\xc9\x38\xa6\x4b\x51\xe7\xab\x90\xfe\x7e\xf3\xaf\xe9\xb4\x07\x47\x95\xd0\x1e\x90\xd9\x76\x39\x6c\x56\xb5\xcb\x4e\xb0\xa6\x6b\x06\xc4\x45\xb3\x8c\x5f\x93\xc7\x3a\x2c\xf8\x11\x90\x12\xe9\xbc\x1d\xf7\xdf\x91\xe0\xe3\xd0\x27\x83\x8b\x0b\x0f\x5e\x54\x64\xbe\xcc\x99\x2d\x89\xba\x22\x82\x6e\xbd\x36\x51\xfb\x6c\x30\x0a\xe0\xd3\x27\x1c\xe1\x00\xfe\xb7\xb5\xeb\xe5\xdb\x71\x70\x3e\xf8\xd0\x27\x9d\xc1\xfb\x21\xea\x95\x55\x9b\x7b\x94\xce\x1e\x0a\xf7\x6d\x2a\x86\xb8\xa8\x5c\x43\x14\xb2\x30\x08\x2e\x3f\x2b\xc7\xe7\x43\xbc\x57\x31\xbb\x02\x78\xef\x81\xa7\x97\x0a\xe7\xff\x54\xf9\xd7\x84\x5c\xf3\x49\x7b\xbb\xd0\x09\xf9\xbf\x69\x98\x85\x3c\x4e\x28\xd0\x3b\x4d\x39\x5e\x9a\xc0\x8c\x86\x31\x95\x0a\x16\x54\xe7\x6c\xad\x10\x1f\xf7\xdf\xf5\x71\xcb\x8c\xaa\xd8\x80\x7e\x6f\x17\x7a\x06\x6c\x9a\xa3\xbd\x80\x5b\x25\xb2\x29\x81\x8f\x81\x5e\xe6\x76\x25\xc7\x69\x1c\xe8\x1d\x53\xba\xcc\x31\x33\xbe\x99\x40\xd3\x8e\xde\xd0\x84\x78\x03\x27\x15\x1c\xe1\x01\x95\x55\x17\x8e\x97\x39\x98\xde\xac\x20\x49\x81\x07\xb6\xa6\x54\x7b\x83\xc1\xbb\xf1\x90\x8c\xfc\x5f\x3c\xd8\x99\xa4\x53\xc2\xb5\x98\xa9\x22\xc6\x32\xe6\xdb\xc8\x53\xa9\x0d\x40\x05\x43\xed\x82\xb5\x7e\xc6\x40\x1b\xde\x2a\x2a\xc0\x81\xff\x68\xc0\xa7\x22\x4a\x40\x97\xa2\x4c\x44\x5b\xa5\x6b\xea\x99\x6b\x93\xaa\x31\x1a\x60\xb3\xd2\x45\x46\x1a\xe1\x36\x94\xc0\xba\x01\xcf\x0a\x3c\x9d\xdb\x2a\x9a\x89\x35\x12\x43\xf4\x74\x25\x0b\x63\xf3\xca\xcb\xe6\xb4\x25\x8c\xb4\x47\xaf\xb5\x8b\x12\xbb\xd7\x4f\xe4\xd2\x22\x6a\xad\xc3\xde\xc7\x96\x75\x92\x57\x42\x0b\x10\x99\x5e\x53\x15\x93\xf2\x42\x90\x38\x15\xf2\x36\x94\x71\x4d\xa6\x28\xa3\xcd\x89\x0b\xf3\xd4\x68\x3d\x28\xc2\x3f\x52\x7c\x5f\x2a\xb9\xfe\xb8\xd7\x6b\xb4\x36\x25\xaa\x1f\x48\x25\x93\x4e\xcf\x6f\x8f\xca\x10\x11\xf7\xb2\x2f\x4c\xea\x92\x51\xf4\x30\x26\x3f\x61\x73\xdc\x26\x64\x9c\x50\xa0\x09\xbb\x62\x13\x44\xe0\x42\xe6\x31\x14\x5a\x5b\x08\x9d\xa0\x96\xf3\x44\x4d\xa8\x54\xa5\xef\x7f\xc0\xe3\xdc\x3a\xb8\x67\x2b\x1e\x0e\x35\xc1\xf4\x2d\x3d\x42\xa7\xdd\x0f\x4a\xf7\xe7\x8a\x4c\x9f\xdc\x23\x9e\x5f\xfc\xd1\x79\xb7\x13\x60\xdc\xab\xd1\x7f\xc1\xcf\xf0\x12\xff\x3c\xc1\x17\x18\x83\x6e\xcc\xf6\x17\xb7\x17\x55\xe3\x6a\xd8\x5c\xb8\x29\x73\xc7\xf2\xf9\x11\x59\xb1\xa3\x75\xe5\xb2\x78\x6a\xa9\xf1\x8b\xb3\x62\x84\x4c\xe8\xab\xc3\x7a\x3e\xcc\xea\x8b\x49\x13\xd4\x12\x41\xcb\xad\xf1\x72\x6b\x5c\xb4\x5a\x32\xff\x94\x64\x96\xd1\x89\x95\x05\x3c\x25\xb8\xf2\x04\xd7\xd1\x37\x4d\x70\xad\xe9\xd3\x1f\x91\xe0\xba\x27\xbb\x65\x54\xd4\xaa\xd8\xc3\xb9\xad\x95\xc4\xd6\x03\x59\xad\xaf\x4c\x69\x1d\x59\xe4\xf1\xf8\xcc\xd6\xd1\xe6\xcc\xd6\xb6\xbd\xde\x22\x3c\xa4\xfe\x94\xd6\x7a\x4a\x6b\x3d\xa5\xb5\xbe\x32\xad\xf5\x95\x49\xad\x3f\x39\xa3\x75\x4f\x3e\xab\x9e\xb2\x5a\x4d\x5a\xe5\x29\x2b\x3f\xf0\x47\xdf\x20\x6d\x65\xd2\x51\xf7\xe7\xac\x4c\x97\x07\x28\x2c\x0d\xfe\xab\xe7\xa7\x9e\x92\x53\x7f\xad\xe4\xd4\x5f\x2c\x33\xf5\x94\x96\xfa\x66\x69\xa9\xdf\x21\xf9\xbf\x4d\x4e\xea\xfb\xcb\x25\x6d\x8b\x5c\xd6\x18\x3b\x2a\x93\x13\xfb\x26\xa3\xf4\x94\x88\xda\x9e\x88\xda\x90\x2b\xf8\x3b\x24\xa2\x4a\xc9\xb5\xdc\xa7\x64\xd2\x7d\xc9\xa4\xdf\x5b\x89\x61\xf2\x6e\xf4\xdb\x55\x62\x3c\x36\x30\xdd\xdb\x85\x8e\x99\xda\x8a\x87\x71\x10\x92\x5d\x31\x1e\x26\x2b\x4a\x5e\xd1\xc9\x7b\x16\xe5\xc9\x68\x9f\xcd\x7b\x6b\x82\x3d\xd7\xf9\x6c\xeb\x9b\x6b\x7b\x6c\x31\xb8\x21\xd3\x5c\xda\x5e\x38\xdd\xb6\xf1\xad\xa2\x7f\xad\x00\x78\xa9\x77\x55\x15\xbc\x2d\xf6\x29\xdd\xce\x8a\xc5\x9c\x9e\xd6\x51\x4f\x7e\x39\x84\x36\xb7\xbd\x5b\xd0\x79\x54\x37\x3c\x33\xad\x17\xc3\x5a\x53\xaa\x44\x72\x43\xa1\x77\x64\x23\x65\x1b\x96\xcf\xc3\x05\x4c\x43\x96\xd8\x50\xba\x0a\xa3\x43\x25\xb8\x6a\xc2\xfb\x70\x81\xe2\xc4\xe0\x3c\xd5\x82\x9b\x62\x16\xab\x82\x21\x7e\xb8\x72\xb7\x30\xc9\x37\xe0\xd9\x7c\x82\x1f\xe0\x08\xf3\xb9\x0e\x9e\x64\x60\x90\xbd\x0c\xa7\x53\x16\x81\x16\xcd\x9c\x0c\x02\x36\x67\x49\x1a\xc9\x11\xb1\x39\x36\x92\x4f\x6e\xb3\x36\x35\x47\xe3\xad\x2c\x13\x3d\x4a\x0e\x6e\xba\x97\xc4\x1f\x8d\x76\x2a\x82\x95\x17\x46\x2b\xa9\xde\xdb\xe8\x64\xa3\xb9\xf6\x8e\xc8\x70\xd0\xeb\x76\x3e\x7a\xb5\x01\x5e\x5d\x78\xf9\xe8\x12\xf8\xda\x1d\x31\xf0\x17\x57\x59\x40\x60\x53\x04\x62\x6a\xb8\x71\x23\xae\x44\xf1\xd1\x89\xa1\x6a\x3c\x13\x17\xb7\x76\x70\xa8\x30\x9d\x19\x0b\xfc\x46\x05\x54\x96\x22\x93\xe5\xde\x21\xb2\x40\x44\x81\xd8\x07\x09\xd0\x3b\xcc\x0f\xd8\x7c\x80\x19\x8e\x72\xc3\xea\x1a\x01\x61\x9a\x26\x0b\x4c\x0e\x65\x92\xe9\x05\xc8\x2c\xa1\xaa\xbe\xd9\xb8\x4d\xd5\xb2\x96\xb6\xc7\x80\x94\x7c\xe9\x25\x0c\xb3\x76\x51\x96\x46\x17\x65\xc4\xe5\x7b\x83\xb3\xe0\xb4\xac\x81\x6e\xb9\xf5\x8a\xeb\x27\x0d\xdf\xa8\xe1\xf4\x3b\x55\x70\xff\x5f\x54\xbf\xf5\x16\xfd\xd6\x9b\xf5\xbb\x3c\x2f\xaa\x89\xe0\x74\x65\xf3\xeb\x5b\xff\xaa\xda\xfb\x4e\x81\x19\xce\xff\x28\xbc\xe5\x15\x54\xd6\x58\x2e\xe0\xc5\x86\xb3\x0f\x5d\xea\xb6\xda\xd9\xf5\xa5\x1c\x5a\x85\xc5\xa9\x8c\x2d\x03\xd4\x0e\xc3\x25\xc2\x4b\x08\x1e\x4b\xf4\xb3\x34\x0e\xd7\x4b\xfe\x3d\xc8\xbf\x02\xf1\x60\xbf\x0c\xd4\xea\x52\x2c\xe7\x26\x17\xed\x6e\xcf\x3f\x6f\xb9\x75\x0c\x11\x72\x93\xb5\xba\x39\xb6\xe8\x40\x0b\xfb\x8d\x17\x98\xda\x75\x55\x07\x12\xcb\xb8\x06\xbf\xb3\xb3\x7f\x5a\x48\x51\x6c\x6d\xe5\x67\x90\x34\xae\xb4\x59\x66\xf6\xcc\x53\x99\xd2\x33\x4f\x45\xb6\xb2\x2e\x09\xf8\xb4\x1a\x24\xe4\x90\x64\xa3\x36\xed\xb7\xdc\xad\x57\x2a\xab\x85\xe5\x15\xd7\xcd\xe5\x52\xf4\xb2\xbc\xdc\x64\x52\xef\x19\x56\xd6\xa4\x5b\xfd\xda\x56\xc2\x5e\x1b\xb2\x52\xf5\xae\x6c\xd5\x7b\x79\xfb\x57\x57\x9d\x4d\x4a\xe3\xc1\xbe\x07\x3b\x35\x82\xab\x4a\x5c\x6b\x2a\x03\x87\xd2\x27\xc1\x07\x2c\x1c\xc5\x6f\x45\x63\x01\x21\xe4\xf1\x9f\xc9\x5e\x47\x33\x1a\x5d\x63\xb1\x55\xc8\xa1\xf7\xca\x6a\x40\x98\x48\x1a\xc6\x8b\xbc\x8a\x43\x95\xe8\x7f\xa3\x02\xd6\x16\xb9\xa6\x86\x46\x27\x30\x35\x21\xe9\x0d\x13\x19\x1e\x27\x29\xe4\x14\x40\x65\x51\x44\x69\x4c\x63\x0f\x6e\x4b\xe6\xcc\x07\x2d\xc0\x34\x1a\xe1\x2e\x4c\x32\x8d\x6d\x73\xf3\x59\xb4\x39\xf2\x93\x04\xcc\x67\x14\x09\x35\x1f\xf4\x1a\xf0\x2a\x32\x8d\x1e\x6d\x63\x02\x61\x45\xeb\x4d\xae\xa4\x32\xb5\x25\x0d\xaa\x1e\x5a\xd5\xa7\x2c\xfb\x1b\x2e\x12\xb7\x85\x01\xdf\xee\xda\xea\x29\x0c\xf8\x0e\xc3\x80\xcd\x06\xfa\xc6\xef\xfb\xa3\x6e\xa7\x8a\xb9\x5f\xbe\xc6\x48\x8c\xdc\x03\x4c\xfe\x8a\xf1\xc4\xe3\x57\xf7\x87\xc6\x15\x4f\xa8\xfb\x8f\x45\xdd\xdf\x95\x1a\xf8\x1b\xb5\xe0\xdb\x63\xd3\xf5\x72\x2e\x5c\xdc\xd6\x92\x2e\x53\xd3\x75\x7c\xd4\x28\x67\xce\xbf\x36\x3e\x85\xca\x9d\xe5\x9c\x6f\x39\xfc\x7f\x2f\xfa\xfd\x46\xf0\x17\xd6\xb6\xe5\x41\xfc\xfb\x60\x82\xfa\xbb\xc2\xbf\xf5\xef\xdd\x2d\xf4\x33\x70\xc5\x5c\x3b\x6d\xc2\xa3\xa8\x4b\xb6\x08\x0c\x7b\xdf\x03\x38\x9d\xa2\xc8\xab\x82\x95\xad\xea\x66\xde\x36\x2e\xcf\x5d\xa2\xce\x62\x0a\x5b\x91\x53\x10\x5b\x2f\x37\x73\xca\x52\xb2\x1a\x08\x6d\xb9\xce\x96\xa3\xb3\x7e\xc5\xbe\x85\x83\x2a\xd3\xbe\x52\xac\xb6\xce\xaa\xd1\x5b\x13\xf3\x7e\xa0\xe6\x6a\x09\x0f\x74\xcc\x19\x9b\x43\x3d\xbf\x63\xc4\xe2\x5d\x83\x46\x80\xf2\xd8\xa6\x56\xed\x9d\x65\x08\x8a\xca\x1b\x16\x19\x15\x80\x5d\xc0\x0c\x2c\xe2\x51\xac\x02\xe1\x68\x05\xd7\xf6\x8a\x12\xb3\x3e\x18\xe2\x4a\x8a\x61\xac\xc6\x3c\xb6\xca\xb0\x8c\xdf\x24\x7f\x24\xbd\x41\x63\xb3\x60\xd1\x94\x8c\x40\xa8\x35\x9d\xa7\xda\x56\xc7\x49\x81\xff\xec\xcd\x4a\x51\x48\x59\x8d\x91\x67\x9c\xb0\x6e\x39\x27\x81\xc1\x76\xce\xa8\x59\x86\x5d\x84\x34\xf0\x33\xff\xb0\xaa\x59\x5d\x9d\xad\xe3\x16\xeb\xd7\xed\xe6\x15\x2a\x54\xc4\x30\x58\x94\xb6\xb5\xb8\xf0\xde\x8f\xd3\xb7\x4a\x43\xdd\x44\xb6\x88\xb0\x7e\x4b\xb2\x55\xca\x55\xf7\x52\x80\xe8\xff\xb6\x38\xde\x2f\x75\x3e\xdf\xe8\xae\xe3\x6b\xfd\xc8\x63\x1d\xc9\x57\x78\x92\x07\x5c\xc9\x56\x2f\x60\x97\x66\xe5\x50\xec\xfc\x52\x95\x4e\x21\xa4\x42\x8a\x2b\xa2\x8e\x57\xc4\xb5\xa2\x5a\x85\x5b\xfa\xec\xd6\x63\x01\x1b\xce\x3f\x2e\x98\xaf\x45\x27\x55\x1c\x87\xdf\x7a\x6f\x77\x6e\x4d\xcb\x9c\x65\x06\x9f\xbc\x72\x5d\xcd\x78\xb9\xd5\x3c\xa2\x95\x37\xd5\x72\x83\xca\x1b\xca\xa5\x35\x0b\xc5\xf8\x33\x32\x07\x7f\xcf\xe8\xfb\x11\x87\x60\x65\x2d\x4b\x7b\x72\x4f\x2c\x6c\x04\x0d\x7b\xbb\xf0\xac\xd4\x8c\x07\x6f\xca\xea\x37\x97\xeb\x31\xf2\x6a\x04\x5c\xff\x07\x7b\x6c\x35\xed\xa6\x7f\xb6\xa7\x18\xbe\x25\x36\xfe\xa2\x00\xfe\x5b\x7f\x51\xfd\x3b\xeb\x4e\xbf\x82\xf7\xef\xa6\x66\xf6\x8b\x78\xff\xb3\xee\x4f\xff\x12\xd9\x9d\x3a\x93\xd6\x4d\xba\xcf\x29\x8f\xd9\xd4\xfd\xff\x01\x00\x71\x68\xac\x36\x34\x50\x00\x00")
 
 func bpfLibConntrackHBytes() ([]byte, error) {
 	return bindataRead(
@@ -738,12 +740,12 @@ func bpfLibConntrackH() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "bpf/lib/conntrack.h", size: 19408, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
+	info := bindataFileInfo{name: "bpf/lib/conntrack.h", size: 20532, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _bpfLibCsumH = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x55\x5b\x6f\xdb\x36\x18\x7d\x96\x7e\xc5\x01\xf2\x12\x67\x6e\x62\xa7\x59\x36\xd4\x6b\x31\xc5\x89\x1b\x63\xb9\x18\xbe\xac\xc8\x93\x40\x4b\x1f\x23\xc2\x32\xa9\xf1\x12\xd7\xe8\xfa\xdf\x07\x52\xb2\x72\x6d\xf7\x64\x93\xfc\xce\xe1\x77\x39\x87\x3a\x3a\x88\x71\x00\x0c\x55\xb5\xd5\xe2\xbe\xb0\xd8\x1f\x76\x70\xdc\xeb\x9f\xbe\x3b\xee\xf5\x7f\x43\xe2\x6c\xa1\xb4\x81\xe2\x18\x8a\x52\xb8\x75\x8c\x1a\x30\x2f\x84\x41\xa5\xd5\xbd\x66\x6b\x08\x03\xae\x89\x60\x14\xb7\x1b\xa6\x69\x80\xad\x72\xc8\x98\x84\xa6\x5c\x18\xab\xc5\xd2\x59\x82\xb0\x60\x32\x3f\x52\x1a\x6b\x95\x0b\xbe\x0d\x44\xc2\xc2\xc9\x9c\x34\x6c\x41\xb0\xa4\xd7\xe1\x32\xbf\xf8\x7c\xb3\xc0\x67\x92\xa4\x59\x89\x89\x5b\x96\x22\xc3\x95\xc8\x48\x1a\x02\x33\xa8\xfc\x8e\x29\x28\xc7\xb2\x26\xf2\x90\x91\xcf\x62\xd6\x64\x81\x91\x72\x32\x67\x56\x28\x39\x00\x09\x5b\x90\xc6\x03\x69\x23\x94\xc4\xf1\xee\x92\x86\xb1\x0b\xa5\x03\xcb\x3e\xb3\x3e\x79\x0d\x55\x79\x60\x07\x4c\x6e\x51\x32\xfb\x88\x3d\xfc\x51\x0b\x1e\x2b\xcd\x21\x64\x60\x2f\x54\x45\xb0\x05\xb3\xbe\xcc\x8d\x28\x4b\x2c\x09\xce\x10\x77\x65\x37\x70\x2c\x9d\xc5\x97\xf1\xfc\xf2\x76\x31\x47\x72\x73\x87\x2f\xc9\x74\x9a\xdc\xcc\xef\x06\xd8\x08\x5b\x28\x67\x41\x0f\x54\x73\x89\x75\x55\x0a\xca\xb1\x61\x5a\x33\x69\xb7\x50\x3c\x50\x5c\x5f\x4c\x87\x97\xc9\xcd\x3c\x39\x1b\x5f\x8d\xe7\x77\x50\x1a\xa3\xf1\xfc\xe6\x62\x36\xc3\xe8\x76\x8a\x04\x93\x64\x3a\x1f\x0f\x17\x57\xc9\x14\x93\xc5\x74\x72\x3b\xbb\x38\x04\x66\xe4\x13\xa3\xc0\xf0\x93\x46\xf3\x30\x2c\x4d\xc8\xc9\x32\x51\x9a\xb6\xf8\x3b\xe5\x60\x0a\xe5\xca\x1c\x05\x7b\x20\x68\xca\x48\x3c\x50\x0e\x86\x4c\x55\xdb\xff\x9f\x61\x60\x61\xa5\x92\xf7\xa1\x54\xd8\x27\xdd\x1c\x40\x70\x48\x65\xbb\xd8\x68\x61\x09\x56\xbd\x9e\x6e\xc0\x3f\x4e\xb8\x8b\xb1\xcc\x0e\xbb\xf8\xb5\x8f\x91\x66\x72\x55\x0a\x89\x99\xed\x62\x24\xb8\x2d\x30\x2a\x95\xd2\x5d\x9c\x29\x63\x7d\xe8\x75\x02\xf4\x8e\xfb\xfd\xde\xbb\xfe\xfb\x5e\x1f\x58\xcc\x92\x18\x07\x47\x71\xbc\x27\xb8\xcc\x89\x23\x4d\xaf\xc6\x67\xe9\x70\xb6\xb8\x4e\x2f\xd3\x78\x2f\x27\x2e\x24\xbd\xd8\x8d\xf7\x84\xcc\x4a\x97\x13\xfe\x28\x85\x74\x5f\x8f\x6c\x56\x1d\x16\x9f\x5e\x6d\xbb\xfc\xcd\x6d\x91\xad\xab\x87\x53\x7f\xd2\xf2\xcf\x87\x93\x9a\xfd\x76\x34\xc2\xbe\xe2\xdc\x90\x55\x7c\xdf\x58\xed\x32\x0b\x9b\x55\x45\xae\xbb\xc8\x0a\xca\x56\x9d\x4e\x8b\x5a\x9c\xff\x0c\xe5\xf2\xe7\xa8\xb8\xd9\xcf\x8c\x5b\xa7\x75\x70\xfc\x2d\x8e\xd2\xd4\xf5\x4f\x51\xaf\x07\xbb\x25\x2f\xd9\xbd\x19\xc4\xdf\x07\x71\x7c\x74\x10\xc6\x7e\x4e\xde\xa1\x42\x9a\xda\x3b\x27\x35\xad\x71\x6b\x70\x41\x65\xde\x10\x78\x97\x43\xd3\x3f\x4e\x68\xca\x6b\x16\x0f\xfe\x93\xe9\x7b\x48\xfa\x6a\x8b\x5c\x47\x57\xef\xeb\xbf\xc4\xbc\xf7\x03\xba\x8d\x51\x9c\x47\x13\x25\xa4\x37\x9d\x55\x70\x52\x48\x61\x05\x0b\xea\x7f\x9d\x7e\xb3\xd5\x08\x73\x46\xd6\x3f\x20\xfc\xb0\x39\xb4\x6a\x97\x14\xd7\x6a\x0d\x63\x99\xb6\x5e\x9c\x57\x27\x68\xee\xb6\xea\x75\x21\x9e\xc9\x17\xe1\x89\x42\xfe\x3b\x09\x3e\xaf\xaa\x0b\xc9\xd6\x54\x6e\x71\x36\x19\xa5\xa3\xf4\x3a\x99\xfe\x95\x5e\x27\x37\x9f\xaf\x2e\xce\xd3\x5e\xf0\xce\xe2\x7c\xe2\x2d\x83\x91\xd2\x70\x72\x25\xd5\x46\xfa\xdb\x2a\xad\xac\xca\x54\x69\xbc\x5b\x9f\xad\x37\x85\xc8\x0a\xe4\xca\xcb\xbf\x76\x16\x6b\x73\xf3\x44\x21\xbd\xae\x4f\xcc\x3f\x39\x4f\x1a\x63\x15\x7a\x87\x41\xc5\xc6\x32\x2b\x32\x08\x59\x7a\x79\x3c\x28\x91\xd7\xed\x2a\x4f\x9a\x8e\xa5\x4c\xe6\x69\x28\x60\x3f\x4d\xdd\xef\xbb\x99\x74\xdf\x6a\xee\x81\xe2\xbc\xe3\x15\x62\x36\xc2\x66\x05\xf6\x9b\xe0\x0e\xbe\xc5\x51\xc6\x0c\x61\x3c\x99\x4c\x6f\xe7\xb7\xe9\x7c\x38\xf9\x10\x47\x91\xe2\xfc\xdd\xa7\x06\xfc\xf1\x99\xa4\x07\x71\x14\x2d\x35\xb1\xd5\x20\x7e\x01\x5d\x9c\xbf\x01\x7d\xaa\xeb\xc1\xee\xb4\x1e\xc6\xc7\x37\x1b\xfe\x63\xfe\xf1\xf0\x7a\xf2\xf7\xe9\xeb\x2b\x5e\x9a\xc5\x7b\xf2\x34\x74\x22\xfc\x4b\x43\xd7\x3b\x3f\xe7\xfd\xf0\x78\x1a\x7d\x8f\xbf\xb7\x56\xb9\xa4\xb2\xaa\xd5\x95\x15\x4c\xde\x3f\x73\x4b\xab\x74\xb3\x5a\x46\x13\x96\xad\xc8\xb6\x5b\xf5\x94\xa2\xdb\x56\xbe\xad\x52\xdb\x10\x3f\x9f\xa7\x06\x79\x3a\x2f\x66\x40\x5f\xad\x66\x99\x0d\x5f\xc6\x1f\x4f\xbe\xd3\xd2\x79\x63\x44\x23\xef\x8e\x07\x56\x3a\xf2\x92\xec\xf9\x07\xd8\x33\x2b\x69\x99\xb7\xbb\xa7\x41\x2e\x38\x6f\x51\x56\x45\x73\xf5\x88\x60\x6f\x84\x84\x8b\xa2\x24\xcf\x85\x7f\xa1\x59\x89\xd6\x4a\x4b\x42\xc5\x8c\xa9\x65\x5b\x9e\xa4\x21\x4b\x4d\x55\xc9\x32\x0a\x99\xbd\x54\xb1\x90\xb6\x2d\x65\x17\xd7\x0c\x2d\x4d\xcd\x2a\x5d\x3a\xce\x71\x60\x56\xcb\x6e\x08\xad\x0b\x7e\x5b\xd0\x7e\xd1\x8d\xa3\x28\x8a\x10\x62\x7d\xf9\x35\xca\xaa\xfa\x37\xa4\x19\x44\xaf\xc9\x3a\x2d\x5f\x65\x18\xee\xa9\xef\xc0\x2f\x81\x7d\x27\xaa\x6e\x43\xe7\xa9\xea\x6a\xff\x6d\xce\x6b\xd2\x81\x57\xc8\x1e\xc9\x5c\x70\x1c\x1d\xf8\x2f\xca\x59\x7a\x99\xfa\x7a\xff\x0b\x00\x00\xff\xff\x08\xf8\xdb\xab\x89\x09\x00\x00")
+var _bpfLibCsumH = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x55\x5b\x53\x22\x47\x18\x7d\x9e\xf9\x15\xa7\xca\x17\x21\xac\x80\x6b\x4c\x6a\xc9\x6e\x05\x51\x56\x2a\xa8\x14\x97\x6c\xf9\x34\xd5\xcc\x7c\xed\x74\x31\x74\x93\xbe\xc0\x52\x9b\xfd\xef\xa9\xee\x19\x46\x5d\x75\xf3\xa4\xdd\xfd\x9d\xf3\xdd\xce\x19\xda\xcd\x18\x4d\x60\xa0\x36\x7b\x2d\x1e\x72\x8b\xe3\x41\x03\xa7\x9d\xee\xf9\xbb\xd3\x4e\xf7\x37\xf4\x9d\xcd\x95\x36\x50\x1c\x03\x51\x08\xb7\x8e\x51\x02\xe6\xb9\x30\xd8\x68\xf5\xa0\xd9\x1a\xc2\x80\x6b\x22\x18\xc5\xed\x8e\x69\xea\x61\xaf\x1c\x52\x26\xa1\x29\x13\xc6\x6a\xb1\x74\x96\x20\x2c\x98\xcc\xda\x4a\x63\xad\x32\xc1\xf7\x81\x48\x58\x38\x99\x91\x86\xcd\x09\x96\xf4\x3a\x24\xf3\x87\xcf\xb7\x0b\x7c\x26\x49\x9a\x15\x98\xb8\x65\x21\x52\x8c\x45\x4a\xd2\x10\x98\xc1\xc6\xdf\x98\x9c\x32\x2c\x4b\x22\x0f\x19\xfa\x2a\x66\x55\x15\x18\x2a\x27\x33\x66\x85\x92\x3d\x90\xb0\x39\x69\x6c\x49\x1b\xa1\x24\x4e\x0f\x49\x2a\xc6\x16\x94\x0e\x2c\xc7\xcc\xfa\xe2\x35\xd4\xc6\x03\x1b\x60\x72\x8f\x82\xd9\x47\xec\xc9\x5b\x23\x78\xec\x34\x83\x90\xa1\x9f\x5c\x6d\x08\x36\x67\xd6\xf7\xbe\x13\x45\x81\x25\xc1\x19\xe2\xae\x68\x85\x74\x4b\x67\xf1\x65\x34\xbf\xbe\x5b\xcc\xd1\xbf\xbd\xc7\x97\xfe\x74\xda\xbf\x9d\xdf\xf7\xb0\x13\x36\x57\xce\x82\xb6\x54\x72\x89\xf5\xa6\x10\x94\x61\xc7\xb4\x66\xd2\xee\xa1\x78\xa0\xb8\xb9\x9a\x0e\xae\xfb\xb7\xf3\xfe\xc5\x68\x3c\x9a\xdf\x43\x69\x0c\x47\xf3\xdb\xab\xd9\x0c\xc3\xbb\x29\xfa\x98\xf4\xa7\xf3\xd1\x60\x31\xee\x4f\x31\x59\x4c\x27\x77\xb3\xab\x13\x60\x46\xbe\x30\x0a\x0c\x3f\x19\x34\x0f\xcb\xd2\x84\x8c\x2c\x13\x85\xa9\x9b\xbf\x57\x0e\x26\x57\xae\xc8\x90\xb3\x2d\x41\x53\x4a\x62\x4b\x19\x18\x52\xb5\xd9\xff\xff\x0e\x03\x0b\x2b\x94\x7c\x08\xad\xc2\x3e\x99\x66\x0f\x82\x43\x2a\xdb\xc2\x4e\x0b\x4b\xb0\xea\xe5\x76\x03\xfe\x71\xc3\x2d\x8c\x64\x7a\xd2\xc2\xaf\x5d\x0c\x35\x93\xab\x42\x48\xcc\x6c\x0b\x43\xc1\x6d\x8e\x61\xa1\x94\x6e\xe1\x42\x19\xeb\x43\x6f\xfa\x40\xe7\xb4\xdb\xed\xbc\xeb\xbe\xef\x74\x81\xc5\xac\x1f\xa3\xd9\x8e\xe3\x23\xc1\x65\x46\x1c\x49\x32\x1e\x5d\x24\x83\xd9\xe2\x26\xb9\x4e\xe2\xa3\x8c\xb8\x90\xf4\xc3\x6d\x7c\x24\x64\x5a\xb8\x8c\xf0\x47\x21\xa4\xfb\xda\xb6\xe9\xe6\x24\xff\xf4\xe2\xda\x65\xaf\x5e\x8b\x74\xbd\xd9\x9e\xfb\x97\x9a\x7f\x3e\x98\x94\xec\x77\xc3\x21\x8e\x15\xe7\x86\xac\xe2\xc7\xc6\x6a\x97\x5a\xd8\x74\x93\x67\xba\x85\x34\xa7\x74\xd5\x68\xd4\xa8\xc5\xe5\xcf\x50\x2e\x7b\x8e\x8a\xab\xfb\xd4\xb8\x75\x52\x06\xc7\xdf\xe2\x28\x49\x5c\xf7\x1c\xe5\xb9\x77\x38\xf2\x82\x3d\x98\x5e\xfc\xbd\x17\xc7\xed\x66\xd0\xfc\x25\x79\x87\x0a\x69\xc2\x3e\xc6\x67\x65\x31\xc6\xad\xc1\x05\x15\x59\x45\xe0\x5d\x0e\x4d\xff\x38\xa1\x29\x2b\x59\x3c\xf8\x4f\xa6\x1f\x20\xe9\xab\xcd\x33\x1d\x8d\xdf\x97\xff\x12\xf3\xde\x0f\xe8\x3a\x46\x71\x1e\x4d\x94\x90\xde\x74\x56\xc1\x49\x21\x85\x15\x2c\xa8\xff\x65\xf9\xd5\x55\x25\xcc\x19\x59\xe3\xcb\x38\xa9\x1e\xad\x3a\x14\xc5\xb5\x5a\xc3\x58\xa6\xad\x17\xe7\xf8\x0c\x55\x6e\xab\x5e\x36\xe2\x4b\xf1\x4d\x78\xa2\x50\xff\x41\x82\xcf\xbb\x6a\x41\xb2\x35\x15\x7b\x5c\x4c\x86\xc9\x30\xb9\xe9\x4f\xff\x4a\x6e\xfa\xb7\x9f\xc7\x57\x97\x49\x27\x78\x67\x71\x39\xf1\x96\xc1\x50\x69\x38\xb9\x92\x6a\x27\x7d\xb6\x8d\x56\x56\xa5\xaa\x30\xde\xad\xcf\xce\xbb\x5c\xa4\x39\x32\xe5\xe5\x5f\x3a\x8b\xd5\xb5\x79\xa2\x50\x5e\xcb\xf7\xe4\x3f\x39\x4f\x06\x63\x15\x3a\x3e\x55\x3b\x36\x96\x59\x91\x42\xc8\xc2\x8b\x76\xab\x44\x56\x8e\xab\x38\xab\x26\x96\x30\x99\x25\xa1\xad\xe3\x24\x71\xbf\x1f\x76\xd2\x7a\x6d\xb8\x4d\xc5\x79\xc3\x2b\xc4\xec\x84\x4d\x73\x1c\x57\xc1\x0d\x7c\x8b\xa3\x94\x19\xc2\x68\x32\x99\xde\xcd\xef\x92\xf9\x60\xf2\x21\x8e\x22\xc5\xf9\xbb\x4f\x15\xf8\xe3\x33\x49\xf7\xe2\x28\x5a\x6a\x62\xab\x5e\xfc\x03\x74\x71\xf9\x0a\xf4\xa9\xae\x7b\x87\xd7\x50\x35\x3e\xbe\x3a\xf0\xb7\xf9\x47\x83\x9b\xc9\xdf\xe7\x2f\x53\xfc\x68\x16\xef\xc9\xf3\x60\x97\xf0\x5f\x12\xa6\xde\xf8\x39\xef\x87\x37\x5f\x67\x83\x79\x68\xab\xdd\xc4\x60\x3a\x78\x7f\x9a\x22\x53\x64\xc2\x62\x53\xb5\x25\x0d\x86\x8d\x21\x97\xa9\x83\x12\x9b\xed\x47\xae\xe8\x7b\xfc\xbd\xb6\xdd\x35\x15\x9b\x52\xa9\x69\xce\xe4\xc3\x33\xe7\xd5\xae\x31\xab\x65\x34\x61\xe9\x8a\x6c\x7d\x55\x6e\x3c\xba\xab\xad\x50\xab\xbe\x0e\xf1\xbb\x7e\x6a\xb6\xa7\xbb\x67\x06\xf4\xd5\x6a\x96\xfa\x5f\xb4\xe5\xfe\x6d\x15\x35\x6a\x3a\x6f\xb2\x68\xe8\x9d\xb6\x65\x85\x23\x2f\xef\x8e\xff\x98\x5b\x85\x54\x49\xcb\xfc\xa7\xc3\xd3\x20\x13\x9c\xd7\x28\xab\xa2\xb9\x7a\x44\xb0\x57\x42\x42\xa2\xa8\x9f\x65\xc2\x7f\xed\x59\x81\xda\x96\x4b\xc2\x86\x19\x53\x5a\xa0\x38\x4b\x3c\x36\xd1\xb4\x29\x58\x4a\xc7\x8d\x57\x1c\x21\x64\x25\xf1\xe2\xac\x8e\xab\x04\x90\x24\x66\x95\x2c\x1d\xe7\x68\x9a\xd5\xb2\x15\x42\xcb\x86\x5f\x37\x87\xa7\x69\xc5\x51\x14\x45\x08\xb1\xbe\xfd\x12\x65\x55\xf9\x37\x94\x19\x0c\xa4\xc9\x3a\x2d\x5f\x54\x18\xf2\x94\x39\xf0\x4b\x60\x3f\x08\xb4\x55\xd1\x79\xaa\x40\x83\x7f\xab\xf7\x70\x6a\xf4\xbc\x42\x8e\x48\x66\x82\xa3\xdd\xf4\xbf\x4e\x17\xc9\x75\x82\x66\x3b\xfe\x6f\x00\x32\x64\xa6\x6e\xd5\x09\x00\x00")
 
 func bpfLibCsumHBytes() ([]byte, error) {
 	return bindataRead(
@@ -758,7 +760,7 @@ func bpfLibCsumH() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "bpf/lib/csum.h", size: 2441, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
+	info := bindataFileInfo{name: "bpf/lib/csum.h", size: 2517, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -843,6 +845,26 @@ func bpfLibEventsH() (*asset, error) {
 	return a, nil
 }
 
+var _bpfLibFlowH = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x55\x5d\x6f\x1b\xb7\x12\x7d\xf6\xfe\x8a\x83\x18\x08\x6c\x61\xaf\x64\x39\xf7\xde\x16\x75\x55\x60\x2d\x58\xc9\x02\x8a\x24\xe8\xa3\x81\x9f\x08\x8a\x9c\xd5\x12\xa6\xc8\x05\xc9\xb5\xaa\x06\xf9\xef\x05\x29\xd9\x52\xd2\xb4\x7d\xed\xbe\x68\x77\x86\x3c\x33\x67\xce\x19\xa8\xd7\xc9\xd0\x01\x86\xb6\xd9\x3b\xb5\xa9\x03\xae\x86\xd7\xb8\xbd\xe9\xff\x80\xa2\x0d\xb5\x75\x1e\xb6\xc2\x50\x69\xd5\x6e\x33\x1c\xce\x2e\x6b\xe5\xd1\x38\xbb\x71\x7c\x0b\xe5\x51\x39\x22\x78\x5b\x85\x1d\x77\x74\x87\xbd\x6d\x21\xb8\x81\x23\xa9\x7c\x70\x6a\xdd\x06\x82\x0a\xe0\x46\xf6\xac\xc3\xd6\x4a\x55\xed\x13\x90\x0a\x68\x8d\x24\x87\x50\x13\x02\xb9\x6d\x2a\x16\x3f\xde\x4f\x56\x78\x4f\x86\x1c\xd7\x98\xb5\x6b\xad\x04\xc6\x4a\x90\xf1\x04\xee\xd1\xc4\x88\xaf\x49\x62\x7d\x00\x8a\x57\x46\xb1\x8b\xc5\xb1\x0b\x8c\x6c\x6b\x24\x0f\xca\x9a\x3b\x90\x0a\x35\x39\x3c\x93\xf3\xca\x1a\xdc\xbe\x14\x39\x22\xe6\xb0\x2e\xa1\x5c\xf1\x10\x9b\x77\xb0\x4d\xbc\x78\x0d\x6e\xf6\xd0\x3c\x9c\xee\x76\xff\x6a\x04\x27\xa6\x12\xca\x24\x3e\xb5\x6d\x08\xa1\xe6\x21\x72\xdf\x29\xad\xb1\x26\xb4\x9e\xaa\x56\xe7\xa9\xdc\xba\x0d\xf8\x54\x2e\x3f\x4c\x57\x4b\x14\x93\x47\x7c\x2a\xe6\xf3\x62\xb2\x7c\xbc\xc3\x4e\x85\xda\xb6\x01\xf4\x4c\x07\x2c\xb5\x6d\xb4\x22\x89\x1d\x77\x8e\x9b\xb0\x87\xad\x12\xc4\xc7\x87\xf9\xf0\x43\x31\x59\x16\xf7\xe5\xb8\x5c\x3e\xc2\x3a\x8c\xca\xe5\xe4\x61\xb1\xc0\x68\x3a\x47\x81\x59\x31\x5f\x96\xc3\xd5\xb8\x98\x63\xb6\x9a\xcf\xa6\x8b\x87\x2e\xb0\xa0\xd8\x18\x25\x84\xbf\x19\x74\x95\xc4\x72\x04\x49\x81\x2b\xed\x5f\xc9\x3f\xda\x16\xbe\xb6\xad\x96\xa8\xf9\x33\xc1\x91\x20\xf5\x4c\x12\x1c\xc2\x36\xfb\x7f\xd6\x30\xa1\x70\x6d\xcd\x26\x51\x45\x38\x9b\xe6\x1d\x54\x05\x63\x43\x8e\x9d\x53\x81\x10\xec\x9f\xd5\x4d\xf7\x4f\x0a\xe7\x28\x8d\xe8\xe6\xf8\x5f\x1f\x23\xc7\xcd\x93\x56\x06\x8b\x90\x63\xa4\xaa\x50\x63\xa4\xad\x75\x39\xee\xad\x0f\xf1\xe8\xc7\x02\xb8\xb9\xed\xf7\x6f\xfe\xd3\x7f\x77\xd3\x07\x56\x8b\x22\x43\xa7\x97\x1d\xf6\x60\x62\x83\xaa\x94\x48\xc6\x89\x3c\x0c\xed\xc0\xb5\xb6\x3b\x92\x10\xd6\x18\x12\x31\xe3\xf1\xac\x38\x1a\x72\x55\x92\x28\xc0\x29\xb3\xc1\xba\xad\x2a\x72\xc7\x21\x15\xb3\xf2\xa7\xf8\xfb\x6c\x95\x84\x27\x23\x59\xa5\xed\x8e\x99\x88\xbf\xbf\xf2\x4f\xeb\x1c\xde\x89\x1c\xd2\x87\x1c\xb2\xb1\x2e\xe4\x30\xf4\x5b\xa8\xa5\xbb\x3e\x42\xcc\x29\x86\x7d\xa2\xef\x6d\xeb\x04\xc5\x15\x82\x24\x1f\x94\x39\x74\xe8\x49\xb4\x4e\x85\x3d\x94\x24\x13\xe2\x0b\xf7\xd8\x91\xd6\x71\x4b\x8e\x12\x9f\x9f\x8f\x80\x09\x64\xfc\xdf\xe8\xde\x60\x85\xd5\x91\x26\x3f\x23\x87\x5d\xad\x44\x8d\x9a\x7b\xac\x89\xcc\x0b\xfd\x48\x66\xbd\xc7\xf8\xdd\xeb\x7d\xab\x95\xd8\xe7\xb0\x46\xc4\xf5\xf6\xe7\x10\xc1\x71\xf1\x14\x67\x42\x26\xb8\xfd\x09\x4b\x38\xe2\x81\xe4\x8b\x93\xca\x0a\xa3\xf1\xf4\x13\x9b\x4c\x97\xe5\xe8\x31\xee\x91\xb1\x01\x92\x2a\x65\x48\xe6\x89\x78\x31\x2b\x5f\x97\x47\xd8\x6d\xa3\xf4\x61\xc3\xb8\x07\xc7\x64\x3a\x8b\x50\xbd\x2c\xbb\x54\x95\x91\x54\x81\xb1\x71\x79\xcf\x12\xe8\x07\x96\x5d\x1e\xa0\xbe\x89\x66\x97\xca\x08\xdd\x4a\xc2\xcf\xeb\xa6\xea\xf1\x46\x75\xeb\x5f\xce\xa2\x6f\x92\xa6\xbe\x5b\xbf\x39\x8b\x09\xbb\xdd\x5a\x13\x63\xb1\x96\xa4\xaf\x3a\xcf\x32\x1f\x78\x50\x02\xca\x68\x65\xe8\x20\x3a\x63\x87\x2f\xc6\xbe\xa3\x7f\x70\xad\x08\x60\xcc\x3f\xb1\x68\x1b\x74\x92\x23\x18\x6b\xdf\xdd\x26\x5f\x64\x17\xf1\xc1\xe1\x39\x84\x93\x53\x18\x6b\xfb\xff\x3f\xfa\xe5\xdb\x33\x3f\x9e\x0c\xf4\x39\xbb\x38\x96\x38\xab\x8a\xad\xdf\x60\x80\xcf\xd9\xc5\x45\x37\xec\x1b\xc2\x00\xc3\x72\x5c\xae\x3e\x1e\x59\xa4\x01\xe5\x31\x7b\x74\xdb\x00\x0f\xbf\x3e\x4c\x96\x6c\x31\x5d\xcd\x87\x0f\x29\x53\x73\x5f\x63\x80\x0d\x05\x16\x5f\x99\x23\xc1\xb5\x88\x7e\xbe\x4e\x79\xef\x04\xd3\x7c\x4d\x1a\x83\x17\x1e\x5d\xe9\xc3\x6b\x2c\x92\x88\xe7\x12\x03\x0c\x4e\x4c\xba\xc7\xde\x31\x78\x61\x91\x67\x17\x5f\xee\xb2\xec\xc2\x3f\xad\x59\x52\x84\xd9\x36\x34\x6d\x88\xc5\x72\xbc\x15\xe9\x2f\xe9\x90\xf1\x39\xee\x67\x23\x36\x62\xc3\xd5\x7c\x1e\x3b\x1e\xce\x56\x39\xde\x6e\xfd\x26\x87\x57\xbf\x93\xad\xae\xb6\x7e\x73\x7d\x7d\x97\x7d\xc9\xb2\x4b\xd2\x9e\xd0\xeb\x7c\x65\xbd\x4e\xef\x5f\xa8\x61\x6a\xd6\x48\x55\x7d\xaf\xdb\x53\xe6\x2b\x77\xa3\xd3\xcb\xfe\x18\x00\x31\xe9\x9a\x24\xd3\x07\x00\x00")
+
+func bpfLibFlowHBytes() ([]byte, error) {
+	return bindataRead(
+		_bpfLibFlowH,
+		"bpf/lib/flow.h",
+	)
+}
+
+func bpfLibFlowH() (*asset, error) {
+	bytes, err := bpfLibFlowHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "bpf/lib/flow.h", size: 2003, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _bpfLibGeneveH = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x54\x6d\x6f\xda\x48\x17\xfd\x8c\x7f\xc5\x79\x1a\x3d\x12\x20\x52\x5e\xba\xed\x56\xa5\xad\x64\x88\x49\x2c\x39\x04\x81\x69\x1b\xed\xae\xac\x01\x5f\xc7\xa3\x0e\x33\xd6\xcc\x98\x84\x4d\xfb\xdf\x57\x63\x5e\x92\xb4\xa4\xab\x9d\x0f\x08\xee\xdc\x7b\xe6\xde\x73\xce\xa5\xdd\xf4\xd0\x04\x86\xaa\xd8\x68\x7e\x93\x5b\xd4\x87\x0d\xf4\x3a\xdd\x37\xa7\xbd\x4e\xf7\x77\xf8\xa5\xcd\x95\x36\x50\x19\x86\x5c\xf0\x72\xe5\x61\x5b\x10\xe7\xdc\xa0\xd0\xea\x46\xb3\x15\xb8\x41\xa6\x89\x60\x54\x66\x6f\x99\xa6\x3e\x36\xaa\xc4\x92\x49\x68\x4a\xb9\xb1\x9a\x2f\x4a\x4b\xe0\x16\x4c\xa6\x6d\xa5\xb1\x52\x29\xcf\x36\x15\x10\xb7\x28\x65\x4a\x1a\x36\x27\x58\xd2\xab\xea\x31\xf7\xe3\x7c\x3c\xc7\x39\x49\xd2\x4c\x60\x52\x2e\x04\x5f\x22\xe2\x4b\x92\x86\xc0\x0c\x0a\x17\x31\x39\xa5\x58\x6c\x81\x5c\xc9\xc8\x75\x31\xdb\x75\x81\x91\x2a\x65\xca\x2c\x57\xb2\x0f\xe2\x36\x27\x8d\x35\x69\xc3\x95\x44\x6f\xff\xc8\x0e\xb1\x05\xa5\x2b\x94\x3a\xb3\xae\x79\x0d\x55\xb8\xc2\x06\x98\xdc\x40\x30\xfb\x50\xfb\xf2\x39\x0a\x1e\x26\x4d\xc1\x65\x85\x9e\xab\x82\x60\x73\x66\xdd\x98\xb7\x5c\x08\x2c\x08\xa5\xa1\xac\x14\xad\x0a\x63\x51\x5a\x7c\x0e\xe3\x8b\xab\x79\x0c\x7f\x7c\x8d\xcf\xfe\x74\xea\x8f\xe3\xeb\x3e\x6e\xb9\xcd\x55\x69\x41\x6b\xda\x62\xf1\x55\x21\x38\xa5\xb8\x65\x5a\x33\x69\x37\x50\x59\x05\x71\x19\x4c\x87\x17\xfe\x38\xf6\x07\x61\x14\xc6\xd7\x50\x1a\xa3\x30\x1e\x07\xb3\x19\x46\x57\x53\xf8\x98\xf8\xd3\x38\x1c\xce\x23\x7f\x8a\xc9\x7c\x3a\xb9\x9a\x05\x2f\x81\x19\xb9\xc6\xa8\x42\xf8\x05\xd1\x59\x25\x96\x26\xa4\x64\x19\x17\xe6\x30\xfc\xb5\x2a\x61\x72\x55\x8a\x14\x39\x5b\x13\x34\x2d\x89\xaf\x29\x05\xc3\x52\x15\x9b\x7f\xd7\xb0\x42\x61\x42\xc9\x9b\x6a\x54\xd8\x47\x6c\xf6\xc1\x33\x48\x65\x5b\xb8\xd5\xdc\x12\xac\xfa\x59\xdd\xaa\xfe\x41\xe1\x16\x42\xb9\x7c\xd9\xc2\xeb\x2e\x46\x9a\xc9\xaf\x82\x4b\xcc\x6c\x0b\x23\x9e\xd9\x1c\x23\xa1\x94\x6e\x61\xa0\x8c\x75\xa9\x97\x3e\xd0\xe9\x75\xbb\x9d\xd3\xee\xab\x4e\x17\x98\xcf\x7c\x0f\xcd\xb6\x77\xc2\x33\x99\x52\x86\x24\x89\xc2\x41\x72\x1e\x8c\x83\x4f\x41\x72\xe1\x9d\xa4\x94\x71\x49\x3f\x86\x0f\xf1\x4b\xff\xcb\x3e\x7a\x35\x89\x93\x28\x18\xe3\xcd\x6f\x68\x37\x91\xf1\xbb\x15\xc1\x94\x45\xa1\xb4\x45\x59\x58\x85\xde\xeb\x1e\x16\x1b\x4b\xa6\x7a\x6f\x07\xb0\x2b\x1e\x46\xfe\x6c\x96\x04\x5f\x26\xc1\x34\xbc\x0c\xc6\xb1\x1f\xa1\x73\x97\x65\x59\xf6\x63\x62\x7c\x3d\x09\x92\x59\x30\x8c\xfc\x41\x10\xa1\x3a\x5d\xaf\xdd\xdc\x3a\xd2\x58\x5d\x2e\xad\x33\x64\xc1\x8c\x71\x8a\x68\xc7\xd2\xde\x92\x8b\x22\x3b\x98\xb6\xd9\xf6\x76\xd9\x37\x24\x69\x4d\xaa\xb0\xc9\x9a\x09\xdc\x7b\xb5\x24\x29\x5f\xf5\x60\x68\x29\xd8\x82\x44\xdf\xfb\xde\xf7\x9e\xe6\x26\xaa\xb0\xb8\xf7\xb0\x3b\x49\xb2\xa0\xee\x1b\xb8\xb5\x49\x96\x82\x19\xd3\x7f\x74\x55\xbe\xdd\x7e\xb3\x9b\x82\xfa\x8e\xe5\x3d\xc9\x71\x1c\x05\x49\x30\x3e\x0b\xfd\x71\x32\x08\xe3\x51\x18\x44\x67\x47\xea\x04\xc9\x1b\x9b\xbf\x7b\x7d\x0c\x53\xbf\x7a\xd7\x3d\x1a\xef\x3d\x13\xef\xba\xf8\x09\x09\x67\xc1\x67\x6e\xff\x03\xda\x33\xaf\x3f\x74\x7c\x42\x32\xe5\xd9\x91\x14\xc7\x54\xca\x2c\xfb\xe3\xaf\x3d\xbb\xcc\xf2\x25\xb8\x14\x4e\x69\x2e\x2d\x0a\xa6\x0d\x25\x0f\x74\x73\x25\x4d\xfd\xa8\x60\xcd\x35\x13\x2d\x94\x5c\xda\xb7\x89\x45\x73\x51\x66\x0d\xef\xde\xab\xfd\x2c\x58\xd3\x7d\x7c\x40\xfd\xc8\x4d\x63\x51\x66\x7d\xcf\xab\xf1\x0c\x75\x55\xd8\xd3\x8f\x07\x29\xf1\xbf\x0f\xbf\xb0\xe8\xb7\x6f\x5e\x6d\x37\xcf\xe9\x47\xa7\xf0\xa3\xf4\xa7\x46\xdd\x67\x6e\xe1\xb7\x14\x01\xef\xdf\xa3\xd7\x70\x35\x86\xff\x4d\x2a\xab\xaf\x99\x38\xfd\xb8\x37\x5e\xa3\xe1\xd5\x6a\x9a\x6c\xa9\x25\xce\xa6\x57\x93\x24\x1c\x7f\xf2\xa3\xf0\x6c\xb7\x6f\xae\xdf\x27\xf9\xf8\xe0\x0c\x9e\x48\xab\x72\x51\x6f\xd6\x2b\x17\x37\x1b\x87\x71\x1c\xdf\x8d\x83\x03\xcf\x82\xc1\xfc\x7c\x87\xe4\xd5\x0a\xcd\xa5\xfd\x5a\x7f\xb1\xe5\xe4\x60\x7d\xfc\xff\xee\x4f\xf9\xa2\x85\xa7\x6d\x1d\x74\xdd\x37\xd7\xe9\x7b\xdf\xbd\x5d\xd0\xad\xfe\xd3\xff\x0a\xb7\x69\xff\x04\x00\x00\xff\xff\x4b\x8f\xfd\xed\x6a\x07\x00\x00")
 
 func bpfLibGeneveHBytes() ([]byte, error) {
@@ -943,7 +965,7 @@ func bpfLibL3H() (*asset, error) {
 	return a, nil
 }
 
-var _bpfLibL4H = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x58\x6f\x6f\xdb\x38\xd2\x7f\x6d\x7d\x8a\x69\x03\x14\xb6\xeb\x26\x4e\x9f\x3c\xbd\x43\xdd\x16\xe7\x3a\x4e\x6b\x5c\x12\x1b\xb6\x83\x22\x77\x58\x08\xb4\x34\xb2\x08\x53\xa4\x8e\xa4\x92\xf8\x76\xf3\xdd\x0f\x43\x51\xfe\x13\x3b\x69\x76\x51\x1c\x6e\x81\xfa\x95\x45\xcd\xfc\x66\x38\x33\xbf\xe1\x50\x47\xcd\x00\x9a\x00\x3d\x95\x2f\x35\x9f\xa7\x16\xea\xbd\x06\xbc\x6d\x1f\xbf\x7b\xf3\xb6\x7d\xfc\x17\xe8\x16\x36\x55\xda\x80\x4a\xa0\xc7\x05\x2f\xb2\x00\x4a\x85\x69\xca\x0d\xe4\x5a\xcd\x35\xcb\x80\x1b\x48\x34\x22\x18\x95\xd8\x5b\xa6\xb1\x03\x4b\x55\x40\xc4\x24\x68\x8c\xb9\xb1\x9a\xcf\x0a\x8b\xc0\x2d\x30\x19\x1f\x29\x0d\x99\x8a\x79\xb2\x74\x40\xdc\x42\x21\x63\xd4\x60\x53\x04\x8b\x3a\x73\xc6\xe8\xe1\xcb\xe5\x15\x7c\x41\x89\x9a\x09\x18\x15\x33\xc1\x23\x38\xe7\x11\x4a\x83\xc0\x0c\xe4\xb4\x62\x52\x8c\x61\x56\x02\x91\xca\x19\x79\x31\xf1\x5e\xc0\x99\x2a\x64\xcc\x2c\x57\xb2\x03\xc8\x6d\x8a\x1a\x6e\x50\x1b\xae\x24\xbc\xad\x8c\x78\xc4\x16\x28\xed\x50\xea\xcc\x92\xf3\x1a\x54\x4e\x8a\x0d\x60\x72\x09\x82\xd9\xb5\xee\xe1\x63\x21\x58\xef\x34\x06\x2e\x1d\x7a\xaa\x72\x04\x9b\x32\x4b\xdb\xbc\xe5\x42\xc0\x0c\xa1\x30\x98\x14\xa2\xe5\x30\x66\x85\x85\x6f\x83\xe9\xd7\xe1\xd5\x14\xba\x97\xd7\xf0\xad\x3b\x1e\x77\x2f\xa7\xd7\x1d\xb8\xe5\x36\x55\x85\x05\xbc\xc1\x12\x8b\x67\xb9\xe0\x18\xc3\x2d\xd3\x9a\x49\xbb\x04\x95\x38\x88\x8b\xfe\xb8\xf7\xb5\x7b\x39\xed\x7e\x1e\x9c\x0f\xa6\xd7\xa0\x34\x9c\x0d\xa6\x97\xfd\xc9\x04\xce\x86\x63\xe8\xc2\xa8\x3b\x9e\x0e\x7a\x57\xe7\xdd\x31\x8c\xae\xc6\xa3\xe1\xa4\x7f\x08\x30\x41\x72\x0c\x1d\xc2\x13\x81\x4e\x5c\xb2\x34\x42\x8c\x96\x71\x61\x56\x9b\xbf\x56\x05\x98\x54\x15\x22\x86\x94\xdd\x20\x68\x8c\x90\xdf\x60\x0c\x0c\x22\x95\x2f\xbf\x9f\x43\x87\xc2\x84\x92\x73\xb7\x55\xb0\x1b\xd1\xec\x00\x4f\x40\x2a\xdb\x82\x5b\xcd\x2d\x82\x55\xbb\xd9\x75\xfa\xeb\x0c\xb7\x60\x20\xa3\xc3\x16\xfc\xff\x31\x9c\x69\x26\x17\x82\x4b\x98\xd8\x16\x9c\xf1\xc4\xa6\x70\x26\x94\xd2\x2d\xf8\xac\x8c\x25\xd1\x8b\x2e\x40\xfb\xed\xf1\x71\xfb\xcd\xf1\xff\xb5\x8f\x01\xae\x26\xdd\x00\x9a\x47\xc1\x01\x4f\x64\x8c\x09\x84\xe1\xf9\xe0\x73\x78\x7e\x12\x7e\x0d\x83\x83\x18\x13\x2e\x71\x6b\x2d\x38\xe0\x32\x12\x45\x8c\xf0\x41\x70\x59\xdc\x1d\xd9\x28\x3f\x4c\x3f\xed\x2c\x17\xf1\xf6\xf2\xcb\x48\x65\x99\x92\x87\xe9\xcb\x8d\xb5\x78\x36\xdf\x5e\x88\x4c\x91\xd1\xca\xca\xf4\xb4\x37\x0a\x4f\x47\xc3\xf1\x34\x1c\x9e\x9d\x41\x5d\x25\x89\x41\xab\x92\xba\xb1\xba\x88\x2c\xd8\x28\x4f\x63\xdd\x82\x18\x8d\x6d\x34\xb6\xb4\x26\xdf\xd7\x32\xaa\xd0\x11\x6e\xe8\x5d\x9d\x3e\x69\xad\x88\xf7\x5a\x23\xad\x27\xac\x55\x5a\x2b\x6b\x41\x70\xd4\x74\x95\x74\xe1\x5a\x01\x9c\x9f\x40\xae\xb4\x6b\x10\x10\x29\xad\x31\xb2\x10\xa5\x18\x2d\x8c\xeb\x39\xf0\x37\xa6\xe7\x60\x16\xb3\xf7\xe0\x7e\x39\x8b\x16\x68\x57\x2f\xc4\x49\xa8\x92\x84\xde\x95\x86\xa9\x62\xce\x4f\x20\x45\x16\xa3\x5e\x49\x79\x91\xb5\x54\xa2\x55\xb6\x96\x23\xa5\xd2\x3f\xe2\x10\xed\x8f\x4b\x57\x5b\xce\xb3\x15\x0a\xa5\xa7\xb4\xf6\x28\xca\xf1\xbb\x19\x5f\xbb\x0f\x09\x47\xe1\x5a\xc2\xae\x4b\x84\x5c\xfa\x24\xf1\xb6\x8c\xc0\x0d\x13\x05\xae\x7d\x16\x71\x58\x0a\x29\x11\x6f\x08\x40\x9d\xa8\xb9\x32\xe1\x43\x46\x1d\xcb\x33\x74\x78\x83\xda\xb1\xc7\x00\xa3\x5a\xa0\x3d\x5d\x9d\x8e\x4a\x08\xc7\x38\xb2\x58\x62\x51\xd0\x13\x7e\x87\x06\x8a\xdc\x31\x6d\x33\xf2\xbe\x95\xad\x37\x48\xd2\x2a\xa1\x64\xbc\xf9\xe4\x6a\xd5\x5b\xbc\x1c\x4e\xfb\xef\xa1\xc7\x84\xe0\x72\x5e\xf2\x39\x29\xa4\x73\xaa\xec\x7d\x5c\xde\x30\xc1\x63\x66\xd1\x75\xd5\x7c\x61\x21\x52\xd2\xe2\x9d\xf5\xa1\x24\x14\x2f\x42\x4a\xb4\xc3\x98\xbb\x52\x28\xf3\x0d\x2c\x8a\xd0\xac\x9a\xd0\x18\x6d\xa1\x25\xb4\x41\x49\x30\x85\x7b\x45\xbb\x64\x20\x71\xce\x2c\xbf\x41\x38\x1d\x0f\x47\x61\x13\x34\x32\xa3\xa4\xa3\xb8\xb1\xcc\xf2\x08\xb8\x14\x54\xb5\x5c\x5a\xaa\x9d\xf2\x38\x72\x71\xae\x0a\x36\x0c\xcd\x22\x9c\x15\x49\x02\x4d\xb3\x98\xb5\x2a\x49\x95\x24\xe5\x7f\xfa\x13\xd4\x6a\xb5\x1a\x78\x85\xaa\x2c\xa8\x22\x9a\xd5\x43\x0b\xc2\xb0\x38\x7e\xe7\x82\x5e\xfd\xaf\x32\xda\x08\x7e\x0d\x6a\x3c\x81\xba\x13\x16\x27\xa1\xc6\x5c\xb0\x08\xeb\xce\x5e\x65\x6b\x8d\x54\xe9\xb5\x3c\x9a\xe1\xff\x46\x95\xd4\x1d\x54\x03\x3e\x40\xbb\x11\xd4\x6a\xba\x0c\x89\xdb\x77\x6f\x72\x75\x11\x9e\x9f\x74\x82\x00\xfc\x8f\xac\x99\xc5\x2c\x34\x56\x69\x0c\x67\x4b\x8b\x66\xd3\x1a\xbc\x76\xdb\x82\x57\xbb\x06\x5a\xd0\xde\x6b\xe3\xdb\x78\x30\xed\x87\xfd\xf1\x78\x38\xee\x04\x41\xf5\xaa\xdd\x09\xee\x57\x04\xef\xe6\xb9\x58\x02\x2b\x0b\x2f\x63\x79\x4e\xd5\x41\xa9\xe5\x32\x52\x19\x3d\x94\xc9\x35\x3f\x8a\xe6\x3b\x04\xfd\x5d\x8c\xcc\x58\x5e\x19\xdf\x74\x18\xa5\xd5\xcb\x95\x50\xbc\xe2\x6d\xaf\xd0\x1a\xa5\x25\xa0\x3d\x1d\x83\x14\x7a\xce\x28\xc5\x9e\x68\xe4\x0b\x59\x22\xc6\x86\x1c\x9b\xe1\xda\x0e\x9d\x9e\x32\x06\x96\xd3\x41\x6f\x9c\xb8\x37\xef\x78\x98\x80\x44\xaa\x71\xa6\x97\x7f\x7e\xca\xd1\xa6\xc3\x8c\xe5\x21\x97\xcf\xa0\xdc\x33\x98\xb6\x25\x42\xe8\x19\xcb\xa1\x99\xb1\xbc\xe2\x5d\xbc\x22\x5d\xe4\x26\xd9\xd0\xea\x15\xdb\x4e\x3f\x7f\x09\xdd\xe1\x75\xd1\x1d\xb5\x60\x96\x27\xa1\xb4\x2a\x35\xf5\x8c\xe5\x6f\x3e\x51\x7f\x6f\xec\xac\x5a\xd5\x68\x50\xc9\x13\xa7\x04\x5f\xa0\x58\xae\xa5\xe1\xc5\x47\x6f\x6e\x83\x2f\x6d\x92\x3e\x6a\xc2\x88\xd2\x5d\xba\x6f\x5c\xbc\xa9\x2b\x53\xde\xa9\x49\xd3\xd8\x4a\x79\x37\x2c\x43\x8a\x5d\xa5\xfc\xb0\x49\x6d\xb6\x88\xad\xf1\x60\xb3\x63\x78\x3f\x5b\xde\x97\x67\x91\x52\x15\x76\xae\xfe\x9c\xa4\x34\xfb\x48\xe9\x0f\xf4\x9f\x7c\x7c\x2e\x1f\x55\xf1\x9c\x33\xb0\x64\xdb\x73\x18\xf9\x14\x25\xcd\x1f\xa5\xa4\x55\xbb\x84\x74\x34\xdd\x4b\x49\xab\x88\x90\xe6\xbf\x4a\xc8\xc9\xa3\x84\x24\x3f\x5b\xde\x1b\x47\xc9\xbd\xf9\x10\x8a\xc5\xdf\x1d\x48\x36\x26\x8c\x66\x15\xca\xea\xac\xf7\x7e\xd2\x71\xef\xb0\x36\x4e\x7b\xa7\xb6\x75\xc6\x3b\x8c\x46\xd5\x21\x60\xe2\x4c\x16\xda\xdd\xbc\xfc\x80\xcf\xe4\x6a\x46\xbf\x4d\x79\x94\x42\xc6\x96\xc0\xe5\x5c\x53\xc9\x71\x69\x15\x49\xa0\x8c\x73\x45\x9e\xb9\x32\x73\x7e\x8b\x93\x90\x09\xa1\x6e\x29\xcb\x47\x4d\xe8\xd2\x7f\x8c\x77\x0e\x4b\x90\x45\x36\x43\xed\x42\xbc\x9e\x99\x7c\x86\x06\x89\xf7\x22\x6e\x95\x34\xd2\xe8\x49\xc1\x84\x00\xab\x59\x92\xf0\xa8\xbc\x25\x96\x97\xc8\xbb\x65\x09\xba\x81\x46\x8b\x1e\xae\xf2\x41\xe2\x9d\x4d\x63\x0d\xf5\xc1\x68\x34\x1e\x4e\x87\xe1\xa0\x77\x31\x6a\x41\xf5\x34\xed\x6d\x3c\x5c\x9d\x8e\x1a\x15\xdc\x5f\x2b\xcd\x4e\x70\xdf\xa1\xcb\x60\x02\x75\xef\x1f\xf4\xce\xbe\xd0\x25\x71\x70\xf9\x65\x4c\x37\xf0\xdf\x7e\x83\x07\x6f\xfa\xee\x45\x03\x5e\xbd\x82\x17\xab\x57\xc3\xcb\xcb\xe9\xb8\xdb\xfb\x7b\x70\x80\x5a\x2b\x0d\x2f\xbd\x30\xf1\xf6\x5f\x05\xd7\x68\xd6\x32\xbe\x4b\xa1\x64\x33\x81\xf1\xcb\xe0\x00\x65\xcc\x13\xe7\x06\xdd\x5d\xb7\x1d\xd8\x53\x5c\x61\x58\x3e\x84\x21\xe5\xc6\x67\x30\xc4\x6c\x86\x71\x8c\x71\x7d\xe3\xb0\x74\xb5\xb5\xda\xac\xe3\xe9\x83\xa4\x02\x2b\x43\xf9\xcf\x5f\xe0\xe3\x03\xcb\x9d\xa0\x46\xd6\x38\x05\x28\xd7\x6c\x9e\x31\x28\xa4\x56\x42\x04\x35\xe2\x58\x9d\xc3\x47\x68\x77\x80\xc3\x07\xe8\x8e\xc7\xdd\xeb\x70\x32\xf8\x47\xbf\xee\xf1\x1a\x1d\xe0\xaf\x5f\x37\xe0\xd7\xa0\xe6\xd8\x5c\x99\xe1\xbf\x1c\x56\x49\x7b\xf5\x0a\xf6\xac\xbe\xf8\xb8\x76\xb7\x56\xab\x51\x6f\xe5\xb2\x40\x4a\xfc\x43\x20\x57\x20\xdb\x28\x6e\x69\x75\x76\xef\x00\x78\x4a\x6d\x2a\x94\x55\x55\xbb\x5f\xcf\xbe\xae\xdf\x8e\x86\xe7\x83\xde\xb5\x1b\xbe\xef\x1f\x49\x50\xff\x79\xf9\xc1\x1f\x9d\x9e\xfe\xcf\xec\xec\xcd\x8e\x1f\x8c\x46\xa8\x13\xa5\xdd\x7d\xbe\x6a\x6e\xb9\x12\x3c\x5a\x82\x50\x6a\x51\xe4\x5b\xf3\x50\xed\xe1\x2c\x54\xde\x0b\x6a\xbb\xdd\xad\xbe\x06\xa3\xc3\x66\xdd\x29\x1b\x2b\x5d\x1f\x99\xf7\xee\x4f\x75\xd7\xae\x3f\xd6\x8c\x5a\x70\x78\x58\x5d\xf5\xa7\xe5\xf5\xdc\xe4\x2c\x42\x6a\x37\xac\x10\xd6\x0d\x33\xab\x22\x80\x42\x0a\xb2\xfe\xa0\x3b\x71\x37\xde\x99\x1c\x23\x9e\xf0\xf2\xab\x65\xd9\xdb\x23\x66\x10\x94\xa4\x11\xd1\xf7\x4a\xe7\xf7\x6b\x6a\xa3\x56\x45\x4a\x40\xce\xb8\x76\xda\xd5\x57\x4d\x2f\xb8\x3d\x98\x98\xf7\xd0\xa6\x41\x29\x52\x52\x96\x9f\x27\x80\x9b\x4a\xd4\x7d\xc1\xab\x7e\x12\x3e\xed\x88\xfa\xef\x8b\x34\x92\x69\x75\x47\x0e\x5a\x05\xf2\x81\xda\x87\x27\xd4\x62\xad\xdc\x14\xe7\x3e\x77\x94\x13\x10\x3c\x36\x03\xad\x69\x17\x6c\xb4\xc5\x32\xf7\x8f\x9d\xc1\x4f\x92\x71\x7f\x3f\xde\x98\x1f\x76\x5a\xaf\x07\xaa\x30\x3a\xc1\x01\x0a\x83\x9b\xb7\x6a\x5f\xac\xf7\xfb\xca\x15\xff\x70\xb5\x7a\xcd\x47\xbf\x78\xfd\xac\xcc\xff\xa1\xca\xc4\x1f\x5c\x98\xfd\x9d\xba\x7c\x78\xe4\xfc\x9e\xb2\xf4\xff\xfe\x13\x00\x00\xff\xff\x2a\xf9\x75\xac\x56\x1a\x00\x00")
+var _bpfLibL4H = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x58\x6d\x6f\xdb\x38\xf2\x7f\x2d\x7d\x8a\xd9\x06\x58\xd8\xa9\x9b\xd8\xd9\xfc\xfb\x3f\xc4\x6d\x71\x8e\xe3\xa4\xc6\x26\xb6\xe1\x07\x14\xb9\xc3\x42\xa0\xa5\x51\x44\x58\x26\x75\x24\x95\xc4\xb7\xdb\xef\x7e\x18\x8a\x92\xed\xd8\xc9\xe6\xf6\x8a\xc3\x2d\xd0\xbc\x08\x2c\x72\x9e\x38\x33\xbf\x99\x21\x8f\x0f\x7d\x38\x04\xe8\xca\x6c\xa5\xf8\x5d\x62\xa0\xd6\xad\xc3\x49\xb3\xf5\xfe\xdd\x49\xb3\xf5\xff\xd0\xc9\x4d\x22\x95\x06\x19\x43\x97\xa7\x3c\x5f\xfa\x50\x30\x4c\x13\xae\x21\x53\xf2\x4e\xb1\x25\x70\x0d\xb1\x42\x04\x2d\x63\xf3\xc0\x14\xb6\x61\x25\x73\x08\x99\x00\x85\x11\xd7\x46\xf1\x79\x6e\x10\xb8\x01\x26\xa2\x63\xa9\x60\x29\x23\x1e\xaf\xac\x20\x6e\x20\x17\x11\x2a\x30\x09\x82\x41\xb5\xb4\xca\xe8\xe3\x6a\x30\x83\x2b\x14\xa8\x58\x0a\xa3\x7c\x9e\xf2\x10\xae\x79\x88\x42\x23\x30\x0d\x19\xad\xe8\x04\x23\x98\x17\x82\x88\xe5\x92\xac\x98\x38\x2b\xe0\x52\xe6\x22\x62\x86\x4b\xd1\x06\xe4\x26\x41\x05\xf7\xa8\x34\x97\x02\x4e\x4a\x25\x4e\x62\x03\xa4\xb2\x52\x6a\xcc\x90\xf1\x0a\x64\x46\x8c\x75\x60\x62\x05\x29\x33\x6b\xde\xa3\xe7\x5c\xb0\x3e\x69\x04\x5c\xd8\xf3\x24\x32\x43\x30\x09\x33\x74\xf6\x07\x9e\xa6\x30\x47\xc8\x35\xc6\x79\xda\xb0\xea\xe6\xb9\x81\x2f\xfd\xe9\xe7\xe1\x6c\x0a\x9d\xc1\x2d\x7c\xe9\x8c\xc7\x9d\xc1\xf4\xb6\x0d\x0f\xdc\x24\x32\x37\x80\xf7\x58\xc8\xe2\xcb\x2c\xe5\x18\xc1\x03\x53\x8a\x09\xb3\x02\x19\x5b\x11\x37\xbd\x71\xf7\x73\x67\x30\xed\x9c\xf7\xaf\xfb\xd3\x5b\x90\x0a\x2e\xfb\xd3\x41\x6f\x32\x81\xcb\xe1\x18\x3a\x30\xea\x8c\xa7\xfd\xee\xec\xba\x33\x86\xd1\x6c\x3c\x1a\x4e\x7a\x47\x00\x13\x24\xc3\xd0\x4a\x78\xc1\xd1\xb1\x0d\x96\x42\x88\xd0\x30\x9e\xea\xea\xf0\xb7\x32\x07\x9d\xc8\x3c\x8d\x20\x61\xf7\x08\x0a\x43\xe4\xf7\x18\x01\x83\x50\x66\xab\xdf\x8f\xa1\x95\xc2\x52\x29\xee\xec\x51\xc1\x6c\x78\xb3\x0d\x3c\x06\x21\x4d\x03\x1e\x14\x37\x08\x46\xee\x46\xd7\xf2\xaf\x23\xdc\x80\xbe\x08\x8f\x1a\xf0\x7f\x2d\xb8\x54\x4c\x2c\x52\x2e\x60\x62\x1a\x70\xc9\x63\x93\xc0\x65\x2a\xa5\x6a\xc0\xb9\xd4\x86\x48\x6f\x3a\x00\xcd\x93\x56\xab\xf9\xae\xf5\x53\xb3\x05\x30\x9b\x74\x7c\x38\x3c\xf6\x0f\x78\x2c\x22\x8c\x21\x08\xae\xfb\xe7\xc1\xf5\x69\xf0\x39\xf0\x0f\x22\x8c\xb9\xc0\xad\x35\xff\x80\x8b\x30\xcd\x23\x84\x0f\x29\x17\xf9\xe3\xb1\x09\xb3\xa3\xe4\xd3\xce\x72\x1e\x6d\x2f\xbf\x09\xe5\x72\x29\xc5\x51\xf2\x66\x63\x2d\x9a\xdf\x6d\x2f\x84\x3a\x5f\xd2\x4a\xa5\x7a\xda\x1d\x05\x17\xa3\xe1\x78\x1a\x0c\x2f\x2f\xa1\x26\xe3\x58\xa3\x91\x71\x4d\x1b\x95\x87\x06\x4c\x98\x25\x91\x6a\x40\x84\xda\xd4\xeb\x5b\x5c\x93\xdf\xe7\xd2\x32\x57\x21\x6e\xf0\xcd\x2e\x5e\xd4\x96\x47\x7b\xb5\xcd\x2e\x5e\xd4\x96\x47\xcf\x68\xa3\xb3\x5d\x5e\x77\xae\x26\xd6\xca\xd6\x4f\xdb\xa7\xa6\x9d\x60\x72\x3b\x08\xce\xfb\x53\x68\x3e\x36\x4f\x76\x77\x3b\xdd\x9f\xdd\x6e\xab\xe9\xfb\xc7\x87\x30\xe9\x4e\x47\x50\x38\x1a\x12\x64\x54\x5a\x62\x99\xa6\xf2\xc1\x96\x0a\x9b\x48\x66\x95\x61\x99\xa1\x31\x57\xda\x40\x98\xe4\x62\x71\x54\xf0\x72\x4d\xb9\x95\x29\x69\x30\x24\x2c\xcf\x57\xc0\xa0\x3b\xee\xfe\x74\x12\x42\x98\x60\xb8\xd0\xf9\x12\x1e\x12\x1e\x26\x10\x49\xd4\x94\xa8\x10\xca\x7b\x54\xc0\x20\xd3\x98\x47\xd2\xe9\x6d\x40\x26\x95\x21\x61\x22\x5f\xce\x51\x69\x5b\x12\x4d\x92\x17\x3c\x73\x42\x0d\x25\xb8\x41\x51\xc1\x5d\x61\xc8\xd2\x30\x4f\x99\xe1\xe2\xce\x1a\x5b\xaa\x24\x39\x56\x0b\x2d\xa2\x30\x5c\x21\x64\x2c\x5c\xa0\x39\xb2\xb9\xeb\x1c\x43\x47\x58\x47\xc2\xf3\x9a\xdb\x3b\x55\x64\x3d\x6f\xed\x4c\xcb\xd3\xfd\x3c\x1b\xfc\x1c\x4c\x6f\x47\x3d\xcb\xd8\x3a\xf1\xf7\xed\xf7\x07\xfd\xa9\xe7\x79\xad\x7d\x7b\x9d\xf3\xe1\x78\xea\x79\xef\xf7\xed\x4d\x3e\xcf\xa6\x17\xc3\x2f\x83\xa0\x3b\xbc\x19\x5d\xf7\xa6\x3d\xaf\x75\xea\x53\xbc\x6c\x2d\xbd\xb1\xfd\x00\xae\x4f\xad\xc7\xa8\x4b\x40\x28\x95\xc2\xd0\x54\x1e\x27\xb2\xbf\x32\x75\x07\x7a\x31\x3f\x03\xfb\x57\x9c\xbe\xda\x48\x4f\x03\x19\xc7\xb4\x57\xe4\x3a\x95\x8d\xeb\x53\x17\x8b\x8a\xca\x91\xac\xa9\x62\x25\x97\x6b\x3a\x62\x2a\x92\x94\x0a\x29\x25\x39\x17\xb6\x85\x54\xb1\xb4\x52\x08\xa3\x85\xb6\x67\xa5\xb4\xde\xcf\xf9\xda\x7c\x88\x39\xa6\xb6\x2f\xec\x9a\x44\x92\xc9\x6c\x00\x81\x0f\x56\x0f\xdc\xb3\x34\xc7\x8a\x40\xa6\x51\x40\xcb\x67\x20\xd3\x68\x83\x00\x6a\x54\x9f\x2b\x15\xce\x65\xd4\xb6\x5c\x99\x1e\xde\xa3\xa2\x0c\x43\x0d\x8c\x20\x43\x67\x9a\x5d\x8c\x0a\x11\x94\x72\x56\x63\x21\x8b\x9c\x1e\xf3\x47\xd4\x90\x67\x3b\x89\xe7\xfa\xd9\xfa\x80\x44\x2d\x63\x0a\xc6\xbb\x4f\xe4\x8c\xb2\x31\x0c\x86\xd3\xde\x19\x74\x59\x9a\x16\xf9\x4b\xa3\x41\x2e\x42\xeb\x41\xdb\x00\xb9\xb8\x67\x29\x8f\x98\x21\x8d\x2b\xc8\x16\x06\x42\x29\x0c\x3e\x1a\xe7\x4a\x92\xe2\x48\x88\x89\x4e\x18\x71\x9b\x0a\x45\xbc\x81\x85\x21\xea\xaa\x13\x8d\xd1\xe4\x4a\x40\x13\xa4\x00\x9d\xdb\x2d\x3a\x25\x03\x81\x77\xcc\xf0\x7b\x84\x8b\xf1\x70\x14\x1c\x82\x42\xa6\xa5\xb0\x75\x5e\x1b\x66\x78\x08\x5c\xa4\x94\xdd\x5c\x18\x48\x4f\x83\x62\x26\xb1\x7e\x2e\xab\x56\x10\xe8\x45\x30\xcf\xe3\x18\x0e\xf5\x62\xde\x28\x29\x65\x1c\x17\xbf\xe9\x87\xef\x79\x9e\x07\x8e\xa1\x4c\x0b\xca\xab\xc3\xf2\xa3\x01\x41\x90\xb7\xde\x5b\xa7\x97\xbf\xcb\x88\xd6\xfd\x5f\x7d\x8f\xc7\x50\xb3\xc4\xe9\x69\xa0\x30\x4b\x59\x88\x35\xab\xaf\xd4\xb5\x96\x54\xf2\x35\x9c\x34\xcd\xff\x89\x32\xae\xd1\x47\xbd\x0e\x1f\xa0\x59\xf7\x3d\x4f\x15\x2e\xb1\xe7\xee\x4e\x66\x37\xc1\xf5\x69\xdb\xf7\xc1\xfd\x91\x36\xbd\x98\x07\xda\x48\x85\xc1\x7c\x65\x50\x6f\x6a\x83\xb7\x14\x87\x06\xfc\xb8\xab\xa0\x01\xcd\xbd\x3a\xbe\x8c\xfb\xd3\x5e\xd0\x1b\x8f\x87\xe3\xb6\xef\x97\x5b\xcd\xb6\xff\xb5\x02\x78\x27\xcb\x52\x2a\xa3\x24\x14\x96\x2c\xcb\x28\x3b\x28\xb4\x5c\x84\x72\x49\x1f\x45\x70\xf5\xb7\x82\xf9\x0e\x40\xff\x2d\x44\x2e\x59\x56\x2a\xdf\x34\x18\x85\x51\xab\x8a\x28\xaa\x70\xdb\xcd\x95\x42\x61\x48\xd0\x9e\x8a\x41\x0c\x5d\xab\x94\xc6\x1a\x42\x96\x4b\x64\x81\x18\x69\x2a\x38\x73\x5c\xeb\xc1\xc8\x96\x3f\x96\xd1\xb4\xa7\x2d\xea\x9c\x7a\x92\x43\x73\x11\x52\x8e\x33\xb5\xfa\xf3\x43\x8e\x0e\x1d\x2c\x59\x16\x70\xf1\x0a\xc8\xbd\x02\x69\x5b\x24\x24\x7d\xc9\x32\x38\x5c\xb2\xac\xc4\x5d\x54\x81\x2e\xb4\xd7\x99\xc0\xa8\x0a\x6d\x17\xe7\x57\x81\xed\x8e\x37\x9d\x51\x03\xe6\x59\x1c\x08\x23\x13\x5d\x5b\xb2\xec\xdd\x27\xea\x12\xf5\x9d\x55\x23\xeb\x75\x4a\x79\xc2\x54\xca\x17\x98\xae\xd6\xd4\xf0\xc3\x47\xa7\x6e\x03\x2f\x4d\xa2\x3e\x3e\x84\x11\x85\xbb\x30\x5f\x5b\x7f\x53\x55\xa6\xb8\x53\x91\xa6\xbb\x0b\xc5\x5d\xb3\x25\x52\xb9\x2a\x99\x9f\x16\xa9\xcd\x12\xb1\x35\x23\x6e\x56\x0c\x67\x67\xc3\xd9\xf2\x2a\x50\xca\xdc\xdc\xc9\x3f\x27\x28\xf5\x3e\x50\xba\x86\xfe\x1d\x8f\xaf\xc5\xa3\xcc\xcd\xab\x01\xf9\x1a\x44\xbe\x04\x49\xfd\x47\x21\x69\xe4\x2e\x20\x09\x78\xfb\x21\x69\x24\x01\x52\xff\x57\x01\x39\x79\x16\x90\x64\x67\xc3\x59\x63\x21\xb9\x37\x1e\xa9\x64\x91\x13\xfd\x7c\x30\x36\x26\x8c\xc3\xd2\x95\x65\xaf\x77\x76\x52\xbb\xb7\xb2\x36\xba\xbd\x65\xdb\xea\xf1\x36\x1c\xe4\x3c\x5b\x21\x60\x62\x55\xe6\x54\x8b\x24\xb8\x81\x9e\x89\x6a\x46\x2f\x2e\x40\x4b\xb6\x02\x2e\xee\x14\xa5\x1c\x17\x46\x02\x13\x80\x22\xca\x24\x59\x66\xcb\x3e\x09\xa1\xf2\xc0\xe8\x0a\x46\x51\x3e\x3e\x84\x8e\xbb\x8e\x3d\x6d\x96\xee\x9e\x64\x5d\xbc\x9e\x99\x5c\x84\xfa\xb1\xb3\x22\x6a\x14\x30\xa2\xb7\x25\x0b\x0a\x96\xa6\x60\x14\x8b\x63\x1e\x92\xad\xe5\x4b\xc2\xe3\xaa\x28\x14\x1b\xd2\x68\xb1\xed\x6f\xd9\x20\xf0\xd1\x24\x91\x82\x5a\x7f\x34\x1a\x0f\xa7\xc3\xa0\xdf\xbd\x19\x35\xa0\xfc\x9a\x76\x37\x3e\x66\x17\x1b\x1f\x74\x29\xaa\x97\xc2\xff\x52\xca\x69\xfb\x5f\xdb\x3e\x3d\x24\x40\xcd\x59\x0b\xdd\xcb\x2b\x7a\x37\xe8\x0f\xae\xc6\xf4\x28\xf3\xdb\x6f\xf0\x64\xa7\x67\x37\xea\xf0\xe3\x8f\xf0\x43\xb5\x35\x1c\x0c\xa6\xe3\x4e\xf7\x67\xff\x00\x95\x92\x0a\xde\x38\x62\xea\xaa\xff\xc8\xb9\x42\xbd\xa6\x71\x35\x0b\x05\x9b\xa7\x18\xbd\xf1\x0f\x50\x44\x3c\xb6\x66\xd0\x73\xc6\xb6\x01\x7b\xa0\x1f\x04\xc5\x47\x10\x50\xa4\x5c\x3c\x03\x5c\xce\x31\x8a\x30\xaa\x6d\xb4\xce\x06\x6c\x1e\x96\x52\xcd\x7b\x12\x62\xb0\xff\x31\xfa\xfb\x2f\xf0\xf1\x89\xe6\xb6\xef\x51\x5a\x70\x72\x50\xa6\xd8\xdd\x92\x41\x2e\x94\x4c\x53\xdf\x23\xc4\xd5\x38\x7c\x84\x66\x1b\x38\x7c\x00\x7a\x06\xbb\x0d\x26\xfd\xbf\xf5\x6a\x4e\x5e\xbd\x0d\xfc\xed\xdb\x3a\xfc\xea\x7b\x76\x60\x2e\xd5\xf0\x5f\x8e\x9c\x35\xe4\xbf\x3d\xab\x3f\x7c\x5c\x9b\xeb\x79\x1e\x4d\x3e\x5c\xe4\xd8\xf6\x77\x05\xd9\x74\xd9\x96\x62\x97\xaa\x4e\xbe\x23\xc0\x01\x6c\x93\xa1\xc8\x31\xef\xeb\x7a\x12\xb6\xd5\x77\x34\xbc\xee\x77\x6f\xed\x28\xfe\xf5\x99\x00\xf5\x5e\x17\x1f\xfc\xd6\xe1\xe9\x7d\x8f\xce\xde\xe8\xb8\x31\x69\x84\x2a\x96\xca\xde\xee\xcb\x52\x97\xc9\x94\x87\x2b\x48\xa5\x5c\xe4\xd9\x7a\xf6\x58\xcc\xcf\x3c\xd7\xa2\xab\x45\x6b\xda\x99\xb7\x73\x31\x80\xda\x5a\x18\xcd\x82\xeb\xba\x59\xaf\x78\x9d\x67\xce\xac\x8b\xdc\x38\x04\xb5\xe7\x4b\xd3\xd1\x51\x79\xf1\x9f\x16\x97\x75\x9d\xb1\x90\xde\x6e\x63\x96\xa7\xc6\x8e\x36\x55\x12\x40\x2e\x52\xd2\xbe\x0d\x51\xf7\xf8\xa5\x33\x0c\x79\x4c\xaf\xcd\x5c\xb8\xa7\xae\x90\x69\x04\x29\xe8\x16\xe7\x2a\xa7\xb5\xfb\x2d\x55\x5a\x23\x43\x99\x42\xc6\xb8\xb2\xdc\xe5\x43\xb7\x23\xdc\x1e\x53\xf4\x19\x34\x69\x6c\x0a\xa5\x10\xc5\x63\x05\xbd\x9d\x3b\x52\x22\xab\xfe\x04\x7c\xda\x21\x75\x4f\xce\x74\x61\x52\xf2\x91\x0c\x34\x12\xc4\x13\xb6\x0f\x2f\xb0\x45\x4a\xda\x3b\x96\x7d\xfc\x28\xee\x27\xf0\xdc\x44\xb4\x86\x9d\xbf\x51\x16\x8b\xd8\x3f\xd7\x91\x5f\x04\xe3\xfe\x7a\x5c\x26\xe2\xbe\xd2\xeb\x04\x95\x32\xda\xfe\x01\xa6\x1a\x2b\x96\x66\xbb\x4c\xd6\xaf\xfb\xd2\x15\xff\x70\xb6\x3a\xce\xa7\x49\x5b\xd1\x7d\xcf\xcc\xff\xa1\xcc\xc4\x6f\x9c\x98\xbd\x9d\xbc\xc4\xff\x20\x2d\x0f\x50\x44\x3c\xf6\xff\x35\x00\xe9\x44\xfc\x83\x69\x1c\x00\x00")
 
 func bpfLibL4HBytes() ([]byte, error) {
 	return bindataRead(
@@ -958,12 +980,12 @@ func bpfLibL4H() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "bpf/lib/l4.h", size: 6742, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
+	info := bindataFileInfo{name: "bpf/lib/l4.h", size: 7273, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _bpfLibLbH = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5c\x7b\x73\xda\x4a\x96\xff\x5b\xfa\x14\x27\x93\x5a\xaf\x61\x88\x1f\xb1\xae\x77\x2a\x1e\x67\x17\x63\x9c\xb0\xc1\xc0\x02\x4e\x26\xb5\xb5\xd5\xd5\x48\x2d\xd3\x85\x90\xb8\xdd\x2d\x13\xef\x4c\xbe\xfb\x56\xbf\x84\x24\x04\x86\xc4\x9b\xd4\xcd\xee\xad\xba\x15\x90\xba\x4f\x9f\xc7\xaf\x4f\x9f\x47\xe3\xe3\xba\x0b\x75\x80\x56\xb2\x78\x64\xf4\x7e\x2a\xe0\xb0\x55\x83\xd7\x27\xa7\xe7\xaf\x5e\x9f\x9c\xfe\x0b\x34\x53\x31\x4d\x18\x87\x24\x84\x16\x8d\x68\x3a\x77\x41\x4f\x18\x4f\x29\x87\x05\x4b\xee\x19\x9e\x03\xe5\x10\x32\x42\x80\x27\xa1\x58\x62\x46\x2e\xe0\x31\x49\xc1\xc7\x31\x30\x12\x50\x2e\x18\x9d\xa4\x82\x00\x15\x80\xe3\xe0\x38\x61\x30\x4f\x02\x1a\x3e\x2a\x42\x54\x40\x1a\x07\x84\x81\x98\x12\x10\x84\xcd\xd5\x62\xf2\xcb\xbb\xde\x1d\xbc\x23\x31\x61\x38\x82\x41\x3a\x89\xa8\x0f\x5d\xea\x93\x98\x13\xc0\x1c\x16\xf2\x09\x9f\x92\x00\x26\x9a\x90\x9c\x72\x23\xb9\x18\x19\x2e\xe0\x26\x49\xe3\x00\x0b\x9a\xc4\x17\x40\xa8\x98\x12\x06\x0f\x84\x71\x9a\xc4\xf0\xda\x2e\x62\x28\x36\x20\x61\x8a\xca\x21\x16\x92\x79\x06\xc9\x42\x4e\xac\x01\x8e\x1f\x21\xc2\x62\x35\xf7\x68\x93\x0a\x56\x92\x06\x40\x63\x45\x7d\x9a\x2c\x08\x88\x29\x16\x52\xcc\x25\x8d\x22\x98\x10\x48\x39\x09\xd3\xa8\xa1\x68\x4c\x52\x01\x9f\x3a\xe3\xf7\xfd\xbb\x31\x34\x7b\x9f\xe1\x53\x73\x38\x6c\xf6\xc6\x9f\x2f\x60\x49\xc5\x34\x49\x05\x90\x07\xa2\x69\xd1\xf9\x22\xa2\x24\x80\x25\x66\x0c\xc7\xe2\x11\x92\x50\x91\xb8\x6d\x0f\x5b\xef\x9b\xbd\x71\xf3\xaa\xd3\xed\x8c\x3f\x43\xc2\xe0\xa6\x33\xee\xb5\x47\x23\xb8\xe9\x0f\xa1\x09\x83\xe6\x70\xdc\x69\xdd\x75\x9b\x43\x18\xdc\x0d\x07\xfd\x51\xfb\x08\x60\x44\x24\x63\x44\x51\xd8\xa2\xe8\x50\x19\x8b\x11\x08\x88\xc0\x34\xe2\x99\xf0\x9f\x93\x14\xf8\x34\x49\xa3\x00\xa6\xf8\x81\x00\x23\x3e\xa1\x0f\x24\x00\x0c\x7e\xb2\x78\x7c\xda\x86\x8a\x0a\x8e\x92\xf8\x5e\x89\x0a\x22\xa7\xcd\x0b\xa0\x21\xc4\x89\x68\xc0\x92\x51\x41\x40\x24\xeb\xd6\x55\xf3\x57\x16\x6e\x40\x27\xf6\x8f\x1a\xf0\xdb\x29\xdc\x30\x1c\xcf\x22\x1a\xc3\x48\x34\xe0\x86\x86\x62\x0a\x37\x51\x92\xb0\x06\x5c\x25\x5c\xc8\xa1\xb7\x4d\x80\x93\xd7\xa7\xa7\x27\xaf\x4e\xcf\x4e\x4e\x01\xee\x46\x4d\x17\xea\xc7\xae\x7b\x5c\x57\xd2\xb5\x92\x38\xa4\xf7\x29\x53\x94\xdf\xc8\x27\xdd\x2b\xd4\xf5\xde\xc8\x35\xa2\x34\x20\xd0\xf5\x60\x8e\x85\x3f\xa5\xf1\xbd\x84\x34\x30\x22\x19\x95\xdf\x7c\xbc\xc0\x13\x1a\x51\x41\x09\xb7\x13\xcf\xde\x40\x3b\xc6\x93\x88\x40\x88\xa3\x68\x82\xfd\x99\x94\xa8\x7b\x06\xdd\x2b\x20\xb1\x60\x7a\xa8\x1c\xdd\xd6\x28\x55\xab\x35\xf4\x5c\x89\x4c\x98\x24\x62\x0a\x31\x21\x81\x9c\x38\x21\xc0\x89\x90\x9f\x88\xa1\x9a\xb0\x25\x66\x81\x24\x20\x18\x8e\x79\xa4\xf8\x3e\x82\x21\x91\x90\x25\xf9\x87\x1a\x84\x78\x19\xe1\x47\x0e\x89\xef\xa7\x0c\x18\xb9\xc7\x2c\x88\x08\x57\xfc\x1a\xbb\x71\x22\xa4\x38\xca\xde\xc7\xae\xeb\xbe\xa4\x61\x1c\x90\x10\x10\xea\x5e\xa1\xf7\xc8\x7d\x19\x90\x90\xc6\x24\xfb\xee\xbe\xa4\x46\x35\x7f\xf2\x79\x3a\x3f\x9a\xfe\x49\x2a\x13\x6e\x3a\x7f\xbb\x6d\xbf\x81\x5b\x3c\x23\xe0\x5b\xa5\x4a\x96\xeb\xc7\x19\x89\x56\xa7\xdb\xb9\xbb\x95\x74\x6e\x9b\x03\x74\xdb\xfc\x1b\x6a\xf7\xc6\xc3\x4e\x7b\xe4\x9c\xff\xf6\xdb\xd9\xf9\x96\x71\x37\x6d\xc7\x79\xfd\xdb\xb9\xeb\x72\xc1\x52\x5f\xc0\x64\x11\x22\x12\x85\x68\x8e\x17\x80\x10\x27\xbe\x94\x58\x7e\xe3\xe0\x2b\xe7\x85\xa2\xc9\x39\x62\x5a\x2b\x28\xc6\x02\x2e\xe1\xef\xae\x73\x24\x1e\x17\xc4\x71\x2e\xe1\x6a\x70\xa3\x68\x8f\x3f\x0f\xda\xe8\x7d\x73\xf4\xbe\xe1\x3a\x47\x9c\xfe\x37\x41\x33\xf2\xe8\x5c\x82\xfc\x98\x84\x87\x08\xa5\xa7\xe7\xb5\xec\xdd\x03\x8e\x52\xb2\x7a\x6b\x78\x29\xad\xa4\x86\x2f\x68\x1c\xd3\xf8\xde\xb9\x84\x41\xa7\x87\xde\x75\xfb\x57\xcd\x2e\xea\x8d\xe4\xab\x39\xfe\x82\x48\x44\xe6\xce\xe5\x66\x7d\x34\xdc\xaf\x17\x7b\xca\xca\x09\x7b\xa0\x3e\xe1\xdf\x26\x68\x4e\x94\x19\x79\xdc\x45\x62\xb3\xde\x4f\x92\x96\x31\xc4\xc9\xef\x39\x59\x61\xf5\xdf\x13\x42\x67\xa3\x76\x11\x7d\xc3\x50\xb9\x78\x4a\xe2\xa2\xf8\xab\xf5\x37\x6a\x21\x1b\x51\x85\xf0\xbd\xd5\xe0\xfd\x30\x80\x7b\x3f\x1d\xe0\xde\x73\x01\xdc\xdb\x0d\xe0\xde\x4f\x05\xb8\xf7\xbf\x02\xf0\x2a\xd1\x7f\x38\xc0\xad\x8f\x1f\xb6\x3f\xa2\x5e\x73\x8c\x6e\xd0\xf8\x6e\xd0\x6d\xa3\x51\xf3\xfa\x7a\x08\xa7\xf2\xf8\x91\xa7\x4f\xf7\x0a\x5d\xb7\xaf\xee\xde\x65\xe3\x8d\x6e\x04\xc3\x3e\x41\xd1\xa4\xf0\xdd\x7d\x49\x22\x4e\x36\x0d\x3d\xc4\x0d\x98\x34\xc0\x6f\x40\x50\x73\x5f\x92\x38\xa0\xa1\x6b\x97\x79\xdf\xfc\xd8\x56\x1c\x7e\x6c\x76\x51\xf3\xfa\xdf\x5d\x2e\xb0\xa0\x3e\xd0\x38\x92\x94\x68\xac\x94\x11\x93\x2f\x02\x31\x66\xd5\x83\x10\x9f\xa1\x49\x1a\x86\x50\xe7\xb3\x49\xc3\x75\x1c\x47\x49\xbd\xae\x3d\xa8\x73\xf2\xfb\x6a\x00\x42\x13\x72\x7a\x0e\x53\xcc\xa7\x35\xf7\xef\xae\x23\xc9\xf3\x48\xc6\x54\x97\x70\x72\xe1\x3a\x08\xa5\x7f\x81\x24\x0c\xe5\x99\x7f\xa9\x86\xc1\x3f\x01\x27\xbf\xbf\x7a\xeb\x27\x69\x2c\x2e\x5c\xd7\xa1\x21\x1c\x9a\x11\x7f\x95\x4a\x1a\x0e\x95\x72\x47\xed\xff\xa8\x49\xa8\x38\xc7\x75\x18\x29\x8a\x27\x32\x4e\x65\x44\xa2\x98\x04\x2a\xb8\x93\x87\xfd\x1c\x73\x19\xdf\xf2\x28\x11\xf2\x60\x76\x1c\xbb\xbc\x5a\x85\x06\x5f\xfe\x53\x13\xff\x2f\xf8\x33\x9c\x5e\xb8\x8e\x93\xd7\xe5\xa1\x94\x16\xae\xaf\xde\xc9\x55\x47\x5d\xa9\xba\x51\xbb\xdb\x50\x8c\x36\xb4\x20\xb5\x0b\xd7\xf9\xea\xba\x0e\x23\x22\x65\xb1\x7e\x76\xe1\x7e\xcd\xd4\x5e\x54\x2f\x42\xe9\xd9\x6b\xa9\x2f\x12\x87\x09\xf3\x09\x62\x44\xd2\xaa\xd6\xb3\xd4\x58\xde\x6a\xa3\xf6\x58\x41\x1f\x75\x7a\x1f\x9b\xdd\xce\xb5\xeb\x70\x22\x90\x9c\x8f\x68\xfc\x80\x23\x1a\x48\x7e\x6b\x17\x06\x1c\x52\x33\x77\xf7\xd1\x23\x2c\x13\x36\xc3\x4c\x46\x93\x4a\x2b\xde\xd1\x5f\x60\x46\x58\x4c\x22\x58\x4e\x09\x23\xb0\x24\x10\x24\xf1\x3f\x0b\x1d\xec\xaa\x58\x35\x4c\x63\x5f\xc7\x59\x52\x67\x9a\x6b\x31\x5f\x48\x7b\xf0\xd9\x04\x45\x09\x0e\xd0\xe4\x51\x10\xae\x35\x04\x27\x0d\x38\x10\xf3\x45\xc3\x6e\x2b\x31\x5f\xd4\xa4\x66\xe4\x60\x2e\x12\x46\xf2\xa3\xab\x06\x37\xd4\x0e\xbf\xb1\xa2\x35\xc7\x7a\x97\x2b\x61\x94\x22\xad\x82\xef\xad\xc8\x8c\xf8\x38\xf2\x8d\xc4\x5f\xcb\x8a\xd6\x38\x96\x87\x76\x44\x7c\x81\x94\x59\xb6\xa0\xd9\xc9\x83\x59\x1d\x8b\x50\x9f\x91\xc7\xec\x9d\x3a\x35\x40\x61\xb2\x61\xbe\x2c\x89\x4c\x29\x15\xaa\xb5\x7e\x14\x7a\x2f\xab\x8c\xab\x58\x2c\x63\x7f\xe3\x86\x54\x88\x37\xd4\x15\xc2\x37\xec\x32\x69\x0c\x47\xbb\xcc\x39\x5e\xa0\x28\x49\x66\xe9\x42\xf9\xa4\xc3\x83\xb5\xc0\xa1\x01\xd2\x13\x4a\x7c\x4b\xea\x72\xd6\xc1\x41\x6e\xa3\xc1\x8b\x4b\x38\xa9\x49\x69\x2d\x87\x79\x27\x20\x8d\xa6\x68\xa8\x7d\xac\x00\x6f\xe1\xad\xc9\xe9\x39\x92\xc4\x77\x6d\xc9\x43\xe3\x00\x14\x4b\xb5\xed\x1b\x72\xf0\x41\x6f\x86\x9d\x36\x63\x25\x38\xbc\x6f\x02\x87\xf7\x0b\x81\xc3\xfb\xb5\xc0\x21\x4f\xbe\x67\xc3\x07\x42\xfa\x0b\x42\x40\xbe\x48\xf2\x02\x45\x1e\x5a\x24\x4c\x6c\x40\x0a\xa8\xb3\x4c\xaa\x65\x1a\x30\x8d\x0d\x7d\x06\x2a\xb4\x79\x28\x09\x43\x8b\x8e\xba\x24\x93\x9d\x87\x8c\xa8\x73\x8e\x2f\xa9\xf0\xa7\x70\x68\x28\x28\x65\xf9\x98\x13\xe8\x0c\x06\xc3\xfe\xb8\x8f\xc6\xad\xc1\x9b\xd2\xa3\xbb\x6b\xf9\x48\xaa\x74\x90\x30\x61\x0e\x52\xae\x74\x79\x77\x3d\x50\xd9\xfb\xb8\x35\x00\xcc\x88\x4e\x7c\xf1\x9c\x68\xa5\x32\x75\xde\x46\x9e\xf6\xe4\x5a\x2a\x29\x83\xe6\x13\xfe\x2c\xa7\xa1\xeb\x41\x7f\x38\x46\xfd\x9b\x9b\x06\x28\x86\x2d\x40\x3a\x23\xd4\x1e\x0e\x0f\x19\x11\x35\x85\x0a\xa3\x4d\x25\x87\xe3\x4c\x18\xc1\x33\x29\x50\x81\xd3\x4e\xeb\x76\xf0\xf1\xbc\xcc\xbf\x7c\xfa\x26\x3f\x27\x20\x21\x4e\x23\x61\x85\xc2\x9c\x43\x1a\xcf\xe2\x64\x19\x43\xd7\x03\x91\x00\x17\xd8\x9f\x65\x32\xc8\x65\xaf\x87\xfd\x01\xba\xeb\x7d\xe8\xf5\x3f\xf5\x50\xd7\x2b\x5a\xf8\xe4\x69\xeb\xda\x38\x5f\x6d\x93\xbd\x2d\xec\x18\x93\xca\x69\x8d\xbc\xa9\xed\x6b\x43\xca\xe7\xe9\x1c\x99\x30\xa6\x6e\xbf\x28\x08\x7c\x87\xdd\xa5\x31\x94\x65\xd4\xc6\x72\x34\x27\x49\xa4\x0d\x2a\x6d\x91\xc7\xd7\xb7\xc0\x64\x37\x9c\x8c\x56\x38\x39\xb0\xab\xd7\xf4\xf2\x15\x68\x29\xc0\xc5\x0e\x92\x53\xa4\x9b\xc9\xa6\x6b\x89\x56\xeb\xeb\xa2\xea\x1a\x07\x8d\x12\x03\x56\xeb\xf2\x3f\xab\xe5\x86\x31\x4e\x91\xb5\x6a\xde\x4a\x58\x76\xbe\xba\xfa\xff\xe7\x02\xf5\x33\x61\x16\xd9\x62\x8f\xcc\x4e\x37\xa1\x75\x0d\x8c\x5b\xb1\x58\x1a\x43\x17\x0f\xe7\xc8\x17\x48\xa4\x8b\x88\x40\x5d\xfd\xa3\x49\x86\x11\xbe\xe7\xa5\xd1\xe5\xd2\x53\x5d\x26\xcd\x12\xdc\x69\x4c\x93\x18\x1e\xce\x71\x10\x30\x65\x00\x2e\x3f\x5d\x94\x5e\xa8\xc8\x52\xe7\x02\xf5\x98\x2c\xb3\x41\x32\x7b\x38\x7b\x0d\x3c\x9d\x5f\x14\x5c\xe5\x46\xa7\xdf\xbd\x3a\x47\xc3\xf6\xc7\xf6\x70\xd4\x96\xa9\x56\x03\x62\x2c\x5e\xbd\x95\xd4\x08\xe7\x47\x0b\xcf\x3c\x30\x28\xd0\xe7\xd3\xea\x89\xc2\x9c\x46\x5c\xa5\x53\x90\xab\x28\x45\xbc\x7a\x6b\x9d\xc0\x8a\xde\x0a\x91\xd9\xee\xde\xc9\x5f\x7e\x35\x6c\x28\xb5\xc2\x41\x75\x96\x28\x59\x33\x11\x41\xab\xdf\xeb\x8d\x87\xcd\xd6\x07\xd4\xed\xb7\x9a\x5d\xb9\x84\x34\x95\x94\x11\xf9\xc9\xe2\xf1\xf0\x20\x53\xb3\x0c\xb3\x35\xbb\xf2\x9b\x66\xa7\x34\x36\xf7\xbe\x01\x07\x79\x6d\xa9\xe1\x99\x31\xe0\x12\x72\x43\x8f\xb4\x7d\x4c\x9a\xb1\x0b\x03\xfc\x29\x0e\xf8\x1e\x2c\xf0\x02\x0f\x3a\x3b\xf8\x0a\x92\x19\x65\x41\xa9\x4d\xb5\x8a\xf2\x59\x6a\xb0\xb6\x5d\x7b\xfc\x1e\xbd\xef\xb6\x7b\xc6\x4d\x69\x9e\xe0\xaf\x26\xb6\xc9\x6f\x4d\x93\x85\x28\xff\xb4\xc6\xb0\xcc\x5c\x9e\xe0\x73\xbe\x30\xec\xd9\x7d\x0d\x97\x7a\x47\xe9\x5c\x28\xc7\x53\x36\x6d\xc5\x9e\x0a\x07\xd7\x61\x93\xe7\xef\xd3\xb0\x33\x6e\xcb\xb7\xfd\xa1\x0a\x1d\xd2\x39\x5c\x6a\xdc\x05\x34\x0c\x0f\x33\xe9\x8e\x34\xe5\xd3\xf3\xc2\x42\xf2\xeb\x89\x5d\x45\xcd\x8a\x3c\xc4\xc8\x22\xca\x02\xec\x32\x94\x55\xce\xc6\xd3\xb9\xcd\xd2\x06\xa3\xf6\xdd\x75\x1f\xbd\x97\xc8\x34\xfa\xcb\xb3\xd7\x1a\xdd\xdd\x2a\xb7\x56\xf2\x69\xc7\xf5\x3a\x0c\x08\x0b\x13\x36\x87\xce\xe0\xe1\xdc\x6e\x33\xe8\x35\xc7\x30\xc1\x9c\x04\x90\xc4\x85\x87\x34\x0e\xc8\x17\x17\xea\xf0\x6f\x98\xdd\x03\x9f\x4d\x1c\x67\x81\xfd\x19\x11\xd9\x33\xcd\xaa\xe3\x18\x7f\x26\x12\xe8\x7a\xd9\x4b\xcb\x7f\xe1\x2d\xf8\x53\xe2\xcf\xa4\xce\x42\x4a\xa2\xa0\x38\x58\x6d\x43\x67\x35\x42\x7e\xcd\x46\x28\x6e\xa4\xa8\x9b\x18\x54\x00\x75\x1c\xf5\xcf\x8a\x6b\x05\x1e\xfd\xaa\x13\x02\x27\xc2\xb8\x11\x30\xf8\x59\x35\xcf\x16\x01\x16\x24\xd0\x3d\xa3\x98\x2c\x81\x27\x29\xf3\xb3\x71\xaa\x5d\xb1\xf5\x5c\x78\xfe\x53\xc1\x84\x37\x4a\xcc\xfd\x8e\x08\x1d\xdc\x6c\x3e\x1f\xf6\x71\xe4\xa8\xdb\xef\x7f\xb8\x1b\x34\x0c\x1f\x1a\xbd\xba\x04\xbc\x35\xf5\x5d\x2d\xd9\x80\x03\x35\xd7\xc2\x5e\x4d\xbe\x84\xde\x5d\xb7\x9b\x43\xef\x49\x0e\xb2\xa5\x33\xb6\x7a\x5b\x18\xa1\xf5\x99\x28\x0f\x83\x5a\x06\xf4\xb6\xce\x1a\x34\xd0\xbb\x57\x32\xc5\x82\x90\x25\x73\x28\x01\xb8\x0a\xd4\x95\x38\xb2\x48\xef\xe7\xb1\x3c\x25\x38\x20\x2c\x1b\x33\x23\x8f\x8e\x33\x48\x68\x2c\x73\x28\x15\x2e\x27\x8c\xd8\xd5\x69\xbc\xbe\x31\xd6\xc7\x96\xf7\x87\x2d\xcf\xc9\x28\xd1\x6e\x07\xd5\xce\xfb\xb2\x20\xbe\xe0\x3a\x62\x9c\x4d\x4c\xf3\x4e\x95\xa1\x14\x88\x65\x74\x19\x50\x46\x7c\x61\x24\x06\xec\xfb\x12\xed\xe9\x42\xf3\x7e\x04\x37\x34\x8a\xd4\xee\xb2\x35\x97\xcc\x07\x74\x3d\x1b\x67\xdb\xa6\xec\x50\x59\x85\xab\x86\x25\xc0\x2b\x18\xb7\x50\xb3\x35\x46\xfd\x0f\x72\x38\x4f\x15\xe9\x30\x8d\x6c\xb2\x46\x93\xd8\x8e\x2c\x05\x5b\x40\x43\xcb\x90\xe9\xf1\x4e\x08\xd0\xfb\x58\x0a\x7f\xc8\x49\x2c\xb2\x34\xa3\x66\x29\xf4\xc8\x3d\x16\xf4\x81\x00\x61\x2c\x61\xe0\x27\x01\xd9\x6d\x2f\xda\xcc\x71\x46\x1e\x37\xed\xc7\x6d\x9b\x69\x43\x12\xb9\xb1\x50\x55\x28\xca\x6e\xd8\xcf\x92\x50\x40\xd9\x7a\x74\x56\x37\x07\xea\x86\x30\xc3\x1c\x6d\xf9\x88\x21\x8b\x00\xcc\xbb\xc3\x80\x32\xb9\xa9\x5a\x63\xd4\xe9\xbd\x1b\xb6\x47\xa3\x1a\xfc\x6b\x31\x04\x80\x37\xd9\xf7\xa0\x78\x80\x97\x8f\xd9\x19\x79\xcc\x0e\xd8\x06\xd8\xf0\xc1\x9e\x56\x5a\x2e\x84\xe3\x40\x7b\xec\xc3\x72\x5c\x96\x0b\xc2\x72\x05\xf6\xae\x97\x6d\xef\xb5\xa4\xbe\x2a\xba\xb3\x1a\xd7\xdc\x04\x26\x6e\x34\x52\xaf\x7c\x86\x91\x61\x2d\x6a\x5f\x6f\x1e\x42\x5d\x7e\x31\xfe\xca\x3c\xdb\x56\x79\xd2\x26\xad\x30\x78\xae\x36\x6c\xe4\x92\x5e\x2d\xc7\x66\xb1\x38\x94\x63\x80\x3f\xf8\x2a\xae\xd9\xea\x7b\xb5\xbf\x45\xb7\xcd\xd1\xb8\x3d\x54\xe5\xa1\x62\x18\x5d\xd0\x87\xe3\xf0\x07\xff\x09\x57\x6c\x7b\x59\xa5\x52\xd3\x83\xaf\x4a\x4d\x0f\xfe\x5a\xa9\xc9\x96\x68\x0c\xb7\xab\x05\x4d\xe7\xe0\x6b\xb9\xb1\xa1\x2e\x14\x68\x35\x9c\xfe\x52\xd2\xe7\x0b\x6a\xbb\xb3\x8d\x6e\x9a\x9d\x6e\x99\xf7\xd7\xe5\x07\x67\x92\x19\xb3\x98\x3c\x0b\x2b\x52\xcf\xa7\x40\xfc\x54\xf1\x74\x63\x6d\xdd\x84\x16\xba\x30\x57\x0a\x16\xca\xf6\x52\x5c\x67\x9d\x1b\x5d\xb6\xdb\x49\x19\xaa\x69\x63\xa4\x56\xf3\xca\xd6\xfb\x06\xe3\x59\xdb\xbd\x30\xf1\x83\x02\xdb\xce\xcc\xa0\xd1\x5d\xab\xd5\x1e\x8d\x1a\xda\xf0\x02\xb3\x7b\x22\x14\xac\xd4\xf7\x0c\x55\x25\x08\x3c\x65\xa6\x8a\xd3\xe7\x4b\x84\xc5\x26\xd3\x40\xd1\xf7\xcb\xec\x20\xe0\xa2\xaa\xc4\x65\x1a\x9c\xea\x00\x3a\x5b\x9d\x21\xa5\xc3\x68\x4b\x4d\xc1\x10\xa8\x84\xc0\x06\x93\xeb\xda\xe8\x2a\x61\x0a\x8a\x09\x53\xc0\x85\xcd\x59\x35\x4b\x59\x32\x9f\xb9\x7d\x65\x93\x55\xfd\xa0\x90\x1e\x15\xb6\x40\x31\x43\xca\x51\xce\xb2\xa4\x67\x4d\x93\xaa\xf3\x24\x69\xdf\x75\x6f\x9e\xe1\x41\x7a\x89\x9c\x03\x7c\x71\x09\xf9\x57\xae\xd2\xb0\xad\x1f\xca\x03\x38\x57\x39\x84\x7f\xfc\x03\x2a\xde\xdc\x5d\x0f\x6a\x56\x47\x72\x13\x8a\xf9\x02\x72\x54\x95\xd4\xb9\xd2\xe1\x77\x14\x98\xb7\x16\xee\x72\x15\xe6\x5c\x4c\x2d\x13\xec\x92\x8b\xdd\xa1\x8c\x92\xf9\x48\xf3\x3c\x0b\x10\x77\xdb\x2c\x51\xe2\xe3\x68\x6b\xd2\xb4\x0e\xfe\x0a\x78\x6f\x88\xba\xb6\xf5\x16\x73\xb3\xab\x53\xaa\x0d\x9b\xa4\x72\x79\x81\xa4\xa4\x72\x80\xfc\xc7\x36\xa0\xac\x9b\x2d\x17\xde\x4c\xcc\xe7\xe6\x1a\x3b\xe5\x96\xa9\x14\x5f\x6f\xd5\xec\x98\x32\x9f\x4d\x17\xca\x38\xc4\x17\x87\xda\x93\xae\x1f\x0d\x2b\x0a\xca\xd3\xaf\x15\x33\x7a\x7d\x34\x6a\x0f\x3f\x76\x5a\xed\x55\xac\xb6\x16\x7e\x6e\x2f\x5c\xe5\x3c\xa9\xe4\x67\x5b\xac\xba\x81\x50\xb0\x13\xa5\x62\xcc\x6a\x76\xa9\x56\xb4\x0c\x37\xb0\x20\xaf\xde\x9a\x8c\x11\xa9\x74\xd3\x6e\xaa\xc2\xc3\x5c\x92\x99\x73\xd3\x52\x4d\x9a\x89\xb5\x28\xd4\x20\x2f\x87\xba\xdc\x76\xb1\xc6\xd9\xd4\x05\x2f\x95\x8d\xbd\x9d\x0a\x04\x1b\xb0\xbe\x6f\x09\xd9\xdb\xa7\x84\xec\xad\x95\x08\xca\x8b\x66\xe0\xb6\x9f\x0c\xbe\x95\x97\x57\x45\x2f\xba\x30\x95\x2e\xf9\x41\xfb\xfd\x93\x9d\x6b\xc7\xde\xe6\xda\xf1\x2f\x59\x38\x36\x1a\x2b\xd6\x76\xe5\xda\xb9\xaf\x70\x69\xf5\x29\x3f\xe5\x34\xb2\x2a\xff\xae\x91\xe1\x25\x3a\xfc\x49\x42\x6b\x35\x5c\xad\xcc\xaa\xab\x26\x1a\x9a\xf0\x67\x73\x16\xad\xae\x70\xd1\x85\xd2\xab\xae\xea\xda\x12\xaf\x84\x81\xb7\x93\x3e\x6d\x09\xb7\x82\xbf\x4c\xd5\x16\x76\xaf\xde\x46\x49\xb2\x98\x60\x7f\x96\xb5\xb4\xc7\x53\x62\xab\x0a\x4b\xcc\x41\xbe\x27\x01\xd8\xeb\xd0\xfa\xd6\x71\x1c\xd0\xf8\x1e\x48\x1c\x2c\x12\x09\xc8\x44\x5d\x7d\x77\x1d\x07\xea\xf6\x96\x33\x58\xff\x5e\xb8\xea\xac\xee\xe2\xeb\x1b\xf2\x5c\x5f\xb8\x97\xf4\x12\x46\xef\x69\x8c\x23\x4d\xa0\x58\x29\xb4\x37\x9d\x0d\x47\xd4\x54\x69\xaa\xc6\xe8\xe9\x7e\xca\x18\x89\x6d\xa5\xe6\x08\x3e\xa9\xf3\x9c\x91\x30\x61\x24\xbb\x9b\x3d\xc7\x33\x7d\xcc\xdb\xd1\x9a\xa0\xa6\x60\xa9\xca\xf7\x31\x59\x42\x40\xb8\xa0\xb1\xbe\x97\x6d\xdf\xa9\xc8\x20\xb7\x5d\x03\xaa\x6e\x14\x7d\x87\xb5\x83\xbc\xb5\x83\xbd\xac\xbd\xd5\x11\x74\xfb\xfd\xc1\x95\xdc\x2b\x23\xb9\xa5\x86\xed\x8f\x0d\xc8\x56\x30\xc0\xaa\x95\x58\x5f\xbb\xe6\xb4\x17\xef\x1a\xa9\xf9\x88\x73\x03\xfb\x55\xf5\xff\xb5\x06\x40\x4e\x1d\x15\xf4\x35\x62\xef\x54\xb1\x59\xff\x32\xa5\x58\x8d\x9e\x52\x7f\xaa\xef\x66\x2c\x18\xe1\x24\x56\x77\xff\xe5\xb8\x0d\x36\xdd\xe8\x60\x8a\x7e\xc4\xb0\xb1\x72\x1c\x25\xf7\x90\x7b\x6f\xfc\x81\xeb\xda\x0b\x98\xdf\xa1\xe6\xcc\x21\x64\xe7\x82\x97\x6b\x7f\x7c\x7b\x93\xa5\xa0\xd6\x02\x71\x9e\xce\x2d\xf9\xe8\x0c\xa9\x19\xc5\xb4\xe1\x09\x8e\x55\xfd\xb5\xb6\x4a\x26\x4e\xb6\x34\x59\xce\xca\x99\xcf\xab\xb7\xe6\x58\xb6\x89\xc1\x8f\x6c\xf0\x94\x3b\x3c\xde\xb3\x74\x78\xce\xd6\x3a\x3c\x67\x7f\x88\xf6\xcf\x93\x05\xe2\x1f\x17\x8b\xad\x87\x4e\xfb\x05\x6b\xc5\xfa\xcc\x7a\xa4\xb6\x4f\x64\x95\x35\x73\x56\xc7\x69\x21\x3c\xde\xb1\xbd\xe3\x95\xda\x3b\x1b\xa8\xed\xd7\xf0\xf1\x4a\x0d\x9f\x62\xe4\xbd\xad\xf1\xb3\xba\xf3\x9c\x05\xa7\x55\xad\x20\xef\x57\x68\x05\xe9\xe9\x7f\xd0\xae\x8c\xb7\x5f\x57\x66\x7d\x57\x3c\xd5\x95\x29\xdf\x10\xdd\xb3\x2b\xb3\xe9\x34\xcd\x57\xad\xca\x21\xbb\x39\x51\x4b\x43\xaa\xfb\x31\xa5\x76\x4c\x75\x37\xe6\x0f\xda\x67\xf1\xf2\x25\x6a\x6f\xef\x3e\x4b\x95\x0d\xf7\x6c\xb4\x78\xeb\xad\x86\xd5\x4f\x05\x65\x92\xf0\x40\x18\x0d\x29\x61\x30\xc1\x6c\xc6\x4d\x12\xc0\x09\xf8\x38\x8a\x38\xe8\x9f\x29\xc7\xc9\x52\x95\xd8\x78\x32\x27\xc0\x08\xe6\x49\xac\x63\xe7\xe3\xfa\xda\x4f\x3d\xca\x61\xeb\xa6\xc6\x45\xb1\xa4\x66\xae\xd6\x3e\x51\xfb\xf6\x7e\x6e\xdb\xe6\xff\x75\xb9\x77\x13\xc8\xab\x6c\x02\x9d\x98\x23\x75\xc7\x46\xcf\x86\x5d\xb4\x5f\xa3\xc7\xdb\xa5\xd1\xb3\x6e\xe1\x7d\x1b\x3d\xde\xb3\x35\x7a\x2a\x0c\xb4\x6f\xa3\xc7\x7b\xba\xd1\xf3\xac\x5d\x1e\x57\x72\xbd\xb5\xcb\x63\x92\x6d\xdd\xdf\xd1\xd5\xc5\xfc\x23\x7d\x21\x4c\xe6\x09\xf6\x69\xee\xee\x5e\xa1\x15\xb4\x39\x14\xdd\xb9\xf4\x5d\x38\x17\xa1\x12\x6e\x59\xeb\xc7\xd6\xeb\x0a\x57\x40\xdd\xe7\xc8\xb6\x73\x9a\xc8\xe7\x81\x4c\xfd\xbc\x6c\x2d\xd1\x79\x2a\x07\x2c\x7a\x05\xaf\x8a\xbc\x09\x3f\xb3\xeb\x81\x07\x07\x39\xdd\xef\x06\xa9\x55\x29\xa2\x51\xb0\x50\x8e\xce\xc5\xf7\xd5\x22\x78\x4e\x3b\xbc\xa8\x9d\xb2\x7a\xf6\x2a\x44\xe4\x98\xf5\xca\xd4\x4d\x9a\x6c\x8b\x6b\x3f\x2f\x55\xae\x65\xd7\x46\xff\xef\x75\xf9\xfe\xf8\x9d\x3d\xa3\xd1\x76\xaf\x79\xd5\x6d\xa3\xce\xe0\xa3\xf7\x74\x06\xf0\x83\x9a\x7d\x15\xbf\x15\x2b\x36\xfb\x2a\x52\xee\x0d\x6e\x71\x87\x66\x5f\xe6\xda\xf5\x66\xce\xb5\x46\xf2\x77\x93\x4f\x72\x3e\xea\xa2\xd4\x1c\xcc\xf7\x01\xcb\xbf\x8e\xdb\xbf\x0f\x58\x8e\x1c\xf6\xea\x03\xee\xd3\x4d\xb3\x63\x73\x6d\x86\xc0\x7c\xce\x9d\xbc\x17\xab\x3f\xf4\x71\xdd\x19\x29\xb0\x64\xae\xb5\x7b\xa5\x7e\x2d\x3b\x5a\x10\x9f\xe2\x08\x6c\x75\x1f\x7c\xcc\x89\x0e\x35\x75\xb5\x7d\x55\xbc\x9f\x62\xae\x8b\xf4\x73\x2a\x84\x2e\x8f\x63\x57\x15\xe2\x8d\xd1\xb2\x2a\xea\x84\xa8\xf2\xa9\x29\xe8\x97\x9b\x02\xaa\x82\x6e\x4a\xfc\x4b\x99\xf7\x2a\x22\x8c\xf0\x34\x12\x40\x63\xc0\x59\x57\x81\x8a\x29\xd0\x80\xc4\x82\xfa\x38\xca\x6a\xf9\x71\x50\x55\x95\x3d\x52\x54\xba\x34\x4e\xbf\x80\x9f\xc4\x9c\x06\x84\x71\x99\x8f\x4f\x0d\x39\x0e\x98\xc3\x1c\x33\x41\x71\x9c\xa7\xa5\x6e\x22\x07\x2c\x59\x40\x1a\xab\xbf\x9a\xa2\xb9\x31\x7f\xfe\x46\x2e\xb0\x52\x4e\x40\xa4\xa0\x47\x59\xbd\xaf\xd7\x1c\x9b\x10\xbc\x7c\x6f\x59\x12\xb1\xfd\x03\x2a\x00\x2f\x16\x04\x33\x5d\x01\xc1\x31\x24\xa9\x90\x0c\x16\x78\x3f\x36\xbe\x51\x9b\xb1\x60\x47\xed\xd0\xf2\x90\x96\x7b\x3e\xb3\xe5\xc5\xaa\x13\x9b\x71\x7a\xa9\x7f\x2b\xb8\x0e\x93\xac\x53\x65\x5e\xf1\x07\x1f\x59\xe4\x64\x17\xf8\x0b\xdd\xde\x17\x15\x0d\xa0\x1d\xeb\xdf\x05\x2c\x96\x6a\xe0\x95\x78\xcd\xd5\xc1\x57\xfd\x62\x2f\xdf\x2f\x3e\xc8\x85\x1b\x07\xb9\xd3\xf5\xc0\x06\x76\x8e\xf3\x54\x37\xb9\xd4\x49\x56\x53\x6c\x37\x39\x4b\xd5\xd4\xbf\x70\x5c\xb7\x7f\x12\x47\x1a\xe8\x7f\x02\x00\x00\xff\xff\x18\xc1\xc4\xcf\x5d\x4b\x00\x00")
+var _bpfLibLbH = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5c\x7b\x73\xdb\x38\x92\xff\x9b\xfc\x14\x3d\x3b\x75\x3e\x4b\xab\xf8\x11\x6b\x7c\x53\xd1\x2a\x77\xb2\x2c\x27\xba\x28\xb2\x4e\x92\x33\x9b\xba\xba\x42\x41\x24\x64\xb1\x44\x91\x1c\x00\xb4\xe3\xdb\xf1\x77\xbf\x6a\x10\xe0\x4b\xd4\x2b\xc9\x66\x36\x73\x3b\x55\x13\x4b\x24\xd8\x68\x74\xff\xba\xd1\x0f\x50\xa7\x75\x1b\xea\x00\xdd\x30\x7a\xe2\xde\xfd\x42\xc2\x71\xb7\x06\x2f\xcf\xce\x2f\x5f\xbc\x3c\x3b\xff\x37\xe8\xc4\x72\x11\x72\x01\xe1\x1c\xba\x9e\xef\xc5\x2b\x1b\x92\x07\xa6\x0b\x4f\x40\xc4\xc3\x7b\x4e\x57\xe0\x09\x98\x73\xc6\x40\x84\x73\xf9\x48\x39\x6b\xc1\x53\x18\x83\x43\x03\xe0\xcc\xf5\x84\xe4\xde\x2c\x96\x0c\x3c\x09\x34\x70\x4f\x43\x0e\xab\xd0\xf5\xe6\x4f\x8a\x90\x27\x21\x0e\x5c\xc6\x41\x2e\x18\x48\xc6\x57\x6a\x32\xfc\xf2\x66\x78\x07\x6f\x58\xc0\x38\xf5\x61\x14\xcf\x7c\xcf\x81\x81\xe7\xb0\x40\x30\xa0\x02\x22\xbc\x22\x16\xcc\x85\x59\x42\x08\x1f\xb9\x41\x2e\x26\x9a\x0b\xb8\x09\xe3\xc0\xa5\xd2\x0b\x83\x16\x30\x4f\x2e\x18\x87\x07\xc6\x85\x17\x06\xf0\xd2\x4c\xa2\x29\x36\x20\xe4\x8a\xca\x31\x95\xc8\x3c\x87\x30\xc2\x07\x6b\x40\x83\x27\xf0\xa9\xcc\x9e\x3d\xd9\x24\x82\x6c\xa5\x2e\x78\x81\x5a\xcf\x22\x8c\x18\xc8\x05\x95\xb8\xf6\x47\xcf\xf7\x61\xc6\x20\x16\x6c\x1e\xfb\x0d\x35\xdd\x2c\x96\xf0\x4b\x7f\xfa\xf6\xf6\x6e\x0a\x9d\xe1\x47\xf8\xa5\x33\x1e\x77\x86\xd3\x8f\x2d\x78\xf4\xe4\x22\x8c\x25\xb0\x07\x96\xd0\xf2\x56\x91\xef\x31\x17\x1e\x29\xe7\x34\x90\x4f\x10\xce\x15\x89\xf7\xbd\x71\xf7\x6d\x67\x38\xed\x5c\xf5\x07\xfd\xe9\x47\x08\x39\xdc\xf4\xa7\xc3\xde\x64\x02\x37\xb7\x63\xe8\xc0\xa8\x33\x9e\xf6\xbb\x77\x83\xce\x18\x46\x77\xe3\xd1\xed\xa4\x77\x02\x30\x61\xc8\x18\x53\x14\xb6\x08\x7a\xae\x94\xc5\x19\xb8\x4c\x52\xcf\x17\xe9\xe2\x3f\x86\x31\x88\x45\x18\xfb\x2e\x2c\xe8\x03\x03\xce\x1c\xe6\x3d\x30\x17\x28\x38\x61\xf4\xb4\x5b\x87\x8a\x0a\xf5\xc3\xe0\x5e\x2d\x15\x64\x4e\x9a\x2d\xf0\xe6\x10\x84\xb2\x01\x8f\xdc\x93\x0c\x64\xb8\xae\x5d\xf5\x7c\xa6\xe1\x06\xf4\x03\xe7\xa4\x01\x3f\x9d\xc3\x0d\xa7\xc1\xd2\xf7\x02\x98\xc8\x06\xdc\x78\x73\xb9\x80\x1b\x3f\x0c\x79\x03\xae\x42\x21\x71\xe8\xfb\x0e\xc0\xd9\xcb\xf3\xf3\xb3\x17\xe7\x17\x67\xe7\x00\x77\x93\x8e\x0d\xf5\x53\xdb\x3e\xad\x2b\xd5\x76\xc3\x60\xee\xdd\xc7\x5c\x61\xe7\x15\x5e\x19\x5c\x91\x41\xf3\x15\xce\xe1\xc7\x2e\x83\x41\x13\x56\x54\x3a\x0b\x2f\xb8\x47\x48\x03\x67\xc8\x28\x7e\x73\x68\x44\x67\x9e\xef\x49\x8f\x09\xf3\xe0\xc5\x2b\xe8\x05\x74\xe6\x33\x98\x53\xdf\x9f\x51\x67\x89\x2b\x1a\x5c\xc0\xe0\x0a\x58\x20\x79\x32\x14\x47\xf7\x12\x94\xe2\x43\xcd\x86\xfa\x73\x81\xc8\x84\x59\x28\x17\x10\x30\xe6\xe2\x83\x33\x06\x82\x49\xfc\xc4\x34\xd5\x90\x3f\x52\xee\x22\x01\xc9\x69\x20\x7c\xc5\xf7\x09\x8c\x19\xc2\x9d\xe5\x2f\x26\x20\xa4\x8f\x3e\x7d\x12\x10\x3a\x4e\xcc\x81\xb3\x7b\xca\x5d\x9f\x09\x64\xc2\xe8\x4d\x30\x29\xbd\xe0\x5e\xe9\xfb\xd4\xb6\xed\x1f\xbd\x79\xe0\xb2\x39\x10\x32\xb8\x22\x6f\x89\xfd\xa3\xcb\xe6\x5e\xc0\xd2\xef\xf6\x8f\x9e\x16\xcd\x9f\x1c\x11\xaf\x4e\x16\x7f\x42\x61\xc2\x4d\xff\xaf\xef\x7b\xaf\xe0\x3d\x5d\x32\x70\x8c\x50\x91\xe5\xfa\x69\x4a\xa2\xdb\x1f\xf4\xef\xde\x23\x9d\xf7\x9d\x11\x79\xdf\xf9\x2b\xe9\x0d\xa7\xe3\x7e\x6f\x62\x5d\xfe\xf4\xd3\xc5\xe5\x96\x71\x37\x3d\xcb\x7a\xf9\xd3\xa5\x6d\x0b\xc9\x63\x47\xc2\x2c\x9a\x13\xe6\xcf\xc9\x8a\x46\x40\x88\x60\x0e\xae\x18\xbf\x09\x70\x94\xf3\x22\xfe\xec\x92\xf0\x44\x2a\x24\xa0\x12\xda\xf0\x37\xdb\x3a\x91\x4f\x11\xb3\xac\x36\x5c\x8d\x6e\x14\xed\xe9\xc7\x51\x8f\xbc\xed\x4c\xde\x36\x6c\xeb\x44\x78\xff\xcb\xc8\x92\x3d\x59\x6d\xc0\x8f\xe1\xfc\x98\x90\xf8\xfc\xb2\x96\xde\x7b\xa0\x7e\xcc\xb2\xbb\x9a\x97\xd2\x4c\x6a\x78\xe4\x05\x81\x17\xdc\x5b\x6d\x18\xf5\x87\xe4\xcd\xe0\xf6\xaa\x33\x20\xc3\x09\xde\x5a\xd1\x4f\x84\xf9\x6c\x65\xb5\x37\xcb\xa3\x61\x3f\xb7\x0e\x5c\xab\x60\xfc\xc1\x73\x98\xf8\xbc\x85\xe6\x96\xb2\x64\x4f\xfb\xac\x58\xcf\xf7\x3b\xad\x96\x73\x22\xd8\xaf\xb9\xb5\x42\xf6\xdf\x8e\x45\xa7\xa3\xf6\x59\xfa\x86\xa1\x38\x79\xcc\x82\xe2\xf2\x35\x61\xd8\x22\x85\x74\xc4\xba\x2c\x6e\x7a\x07\x8b\xa1\xf9\xcd\x00\x5e\x98\xe9\x77\x51\x79\xf3\x6b\x01\xbc\x59\xa1\xe5\xca\x61\xbf\x27\xc0\x9b\x7f\x17\x80\x57\x2d\xfd\x9b\x03\xdc\xf8\xf8\x71\xef\x03\x19\x76\xa6\xe4\x86\x4c\xef\x46\x83\x1e\x99\x74\xae\xaf\xc7\x70\x8e\xdb\x0f\xee\x3e\x83\x2b\x72\xdd\xbb\xba\x7b\x93\xee\x09\x5a\x36\x92\x53\x87\x11\x7f\x66\x64\xa5\xbe\xdb\x3f\x32\x5f\xb0\x4d\x43\x8f\x69\x03\x66\x0d\x70\x1a\xe0\xd6\xec\x1f\x59\xe0\x7a\x73\xdb\x4c\xf3\xb6\xf3\xa1\xa7\xb0\xf9\xa1\x33\x20\x9d\xeb\xff\xb4\x85\xa4\xd2\x73\xc0\x0b\x7c\x64\xd2\x0b\x50\x6e\x24\x60\x9f\x24\xe1\xdc\x88\x87\x10\xb1\x24\xb3\x78\x3e\x87\xba\x58\xce\x1a\xb6\x65\x59\xb8\x68\x58\x97\x1e\xd4\x05\xfb\x35\x1b\x40\xc8\x8c\x9d\x5f\xc2\x82\x8a\x45\xcd\xfe\x9b\x6d\x21\x79\xe1\x63\x4c\xd5\x86\xb3\x96\x6d\x11\x12\xff\x0c\xe1\x7c\x8e\x7b\x7e\x5b\x0d\x83\x7f\x01\xc1\x7e\x7d\xf1\xda\x09\xe3\x40\xb6\x6c\xdb\xf2\xe6\x70\xac\x47\xfc\x05\xa3\x86\xf1\x58\x99\xd5\xa4\xf7\x5f\x35\x84\x8a\x75\x5a\x87\x89\xa2\x78\x86\x71\x2a\x67\x88\x62\xe6\xaa\xe0\x0e\x37\xfb\x15\x15\x18\xdf\x0a\x3f\x94\x18\x05\x59\x96\x99\x5e\xcd\xe2\xb9\x9f\xfe\x3b\x21\xfe\x3f\xf0\x67\x38\x6f\xd9\x96\x95\x97\xe5\x31\xae\x16\xae\xaf\xde\x90\xf1\x98\x4c\x06\x28\xba\x49\x6f\xd0\x50\x8c\x36\x40\x51\xaa\xb5\x6c\xeb\xd9\xb6\x2d\xce\x64\xcc\x83\xe4\x5a\xcb\x7e\x4e\xc5\x5e\x14\x2f\x21\xf1\xc5\x4b\x94\x17\x0b\xe6\x21\x77\x18\xe1\x0c\x69\x55\xcb\x19\x25\x96\xd7\xda\xa4\x37\x55\xd0\x27\xfd\xe1\x87\xce\xa0\x7f\x6d\x5b\x82\x49\x82\xcf\x13\x2f\x78\xa0\xbe\xe7\x22\xbf\xb5\x96\x06\x07\x4a\xe6\xee\xde\x7f\x82\xc7\x90\x2f\x29\xc7\x68\x52\x49\xa5\x79\xf2\x33\x2c\x19\x0f\x98\x0f\x8f\x0b\xc6\x19\x3c\x32\x70\xc3\xe0\x5f\x65\x12\xec\xaa\x58\x75\x1e\x07\xca\x4a\x4f\x94\xcc\x12\xae\xe5\x2a\x42\x7d\x88\xe5\x8c\xf8\x21\x75\xc9\xec\x49\x32\x81\x33\x36\x00\xce\x1a\x70\x24\x57\x51\xc3\x98\x95\x5c\x45\x35\x94\x0c\x0e\x16\x32\xe4\x2c\x3f\xba\x6a\x70\x43\xf9\xef\x1b\xb3\xb4\xce\x34\xf1\xe1\x6a\x31\x0a\xbf\x46\xc0\xf7\x66\xc9\x9c\x39\xd4\x77\xf4\x8a\x9f\xcb\x82\x46\xa0\xe1\x06\x27\x98\xcf\x1c\x49\x94\x5a\xb6\xa0\xd9\xca\x83\x59\x6d\x8b\x50\x5f\xb2\xa7\xf4\x9e\xda\x35\x40\x61\xb2\xa1\xbf\x3c\x32\x4c\x29\x51\x47\x88\xe2\x8b\x97\x0a\x14\xd0\xae\x52\xae\x62\xb1\x8c\xfd\x8d\x06\xa9\x10\xaf\xa9\x2b\x84\x6f\xb0\x32\x54\x86\x95\xb8\xcc\x15\x8d\x88\x1f\x86\xcb\x38\x52\x3e\xe9\xf8\x68\x2d\x70\x68\x00\x7a\x42\xc4\x37\x52\xc7\xa7\x8e\x8e\x72\x86\x06\x3f\xb4\xe1\xac\x66\x5b\x99\x79\xe4\x9d\x00\x2a\x4d\xd1\xc0\xe5\xe0\x52\x32\x78\x2b\x66\xf5\x33\x48\xe2\x8b\x4c\xf2\x58\x3b\x00\xc5\x52\x6d\xbb\x41\x8e\xde\x25\xc6\xb0\x97\x31\x56\x82\xa3\xf9\x59\xe0\x68\xfe\x81\xc0\xd1\xfc\x63\x81\x83\xf8\xb3\xcf\xc5\x87\xce\x8d\xc7\xea\xba\x00\xc9\x63\x86\x69\x3a\x32\x14\x51\x67\xc9\x24\xac\xe8\x13\x84\x11\x0b\x80\x42\xc0\x1e\x31\xe1\x0b\x98\x76\x91\xdd\xf4\x33\xe6\xae\x71\x04\x0e\x0d\x54\xba\x19\xf8\x4f\x58\x13\x71\x99\x64\x8e\xd4\x6b\x9c\x76\x47\x2a\xab\x9e\x74\xa7\xa3\xb4\x0e\x82\x75\x85\x20\xc0\x45\x2c\x21\x51\x55\x43\xcf\x2b\x74\x25\x84\xfa\x3e\x84\x2a\x7f\x8e\x78\x28\x43\x27\xf4\x05\x60\x19\xc8\x09\x03\xe1\xb9\x8c\x27\xd9\x73\x35\x83\x2a\xcd\x5d\xb7\x00\x42\x12\x73\x20\x04\xf5\xe9\x09\x12\xb0\x47\x82\x7c\x6c\x30\x05\xc4\xf9\xcf\x80\x7a\x5f\xb8\x3c\x01\x3f\xba\xcd\xc4\x9a\x9a\x24\x9c\xcf\x0d\xde\x7f\x4e\xf7\x8a\x47\x4f\x3a\x0b\x38\xd6\x0f\x29\xc4\x3a\x54\x30\xe8\x8f\x46\xe3\xdb\xe9\x2d\x99\x76\x47\xaf\x0c\xec\x2a\xf6\x95\x84\x2c\xfc\x19\xa6\xdd\x11\xb9\x19\x74\xde\x4c\xc8\xed\xcd\x8d\xd9\x3e\xce\x6b\xf0\x17\x0d\x4c\xad\x50\x05\x09\xfd\xf9\x58\xae\x22\x38\x82\x63\xf3\x28\x99\x7c\x1c\x92\xab\xfe\x14\x7e\x4b\xa9\x91\x4e\xf7\x1d\x5e\xaa\xd5\x10\x9f\xe5\x81\x2d\xbb\xc4\x2c\xaa\x6c\x2f\x6e\x71\x20\xe9\xbe\xbd\x1b\xbe\x4b\xb2\x91\xbd\x79\x46\x96\xdb\xed\xfc\xf3\xfd\x61\xc2\x88\xcb\xe6\x34\xf6\xe5\xab\x6c\x2c\xae\xf5\xb9\xda\xb7\xe5\x34\xcb\x3e\x21\xaa\x24\xf1\x9b\x24\x0a\xb9\x3c\x50\xb5\x39\xdd\x1a\xcf\x56\x47\x32\x69\x2c\xc7\x99\x3c\x40\xcf\x85\x4b\x77\xd7\xa3\x57\x1b\x04\x7c\x5a\x87\x51\xc8\xa5\x0e\x0c\x85\x8a\x5a\xee\xae\x47\x8d\xa2\xf1\x20\xfc\xd1\x40\x05\x5d\x31\x44\xb8\x92\x0c\x3a\xa7\x66\x82\x23\x64\xb4\x02\x46\xd7\xa3\xdb\xf1\x34\x81\x11\x8e\x48\xdd\x5e\x7f\x42\x7a\xe3\xf1\x31\x67\xb2\x96\x57\x8f\x5a\xa1\x65\xcd\x38\xa3\xcb\x35\x44\xf4\xbb\xef\x47\x1f\x2e\xcb\xcb\xc0\xab\xaf\xf2\xcf\xe4\x94\x87\x4b\xa3\x42\x40\x1c\x2c\x83\xf0\x31\xc0\x62\x9b\x0c\x41\x48\xb4\x7c\xb3\x06\x9c\xf6\x7a\x7c\x3b\x22\x77\xc3\x77\xc3\xdb\x5f\x86\x64\xd0\x2c\xfa\xad\xb3\xd6\x4e\xbd\x9b\xec\x55\x39\xff\x83\x75\x6f\x69\x65\xe3\x63\x8d\x3c\x08\xcc\x6d\x4d\x0a\xcb\x61\x44\x07\xe7\x75\xf3\x45\x81\xe3\xf3\x11\xa1\x94\x81\x13\x2b\x14\x59\xe8\x4f\xce\x2f\x21\xf4\x13\x85\xa2\xb6\xf2\xc8\xdb\x08\x16\x85\x93\x69\xb7\x0a\x26\xfb\xe1\x64\x92\xe1\xe4\xc8\xcc\xae\xc0\x52\x89\x16\xa3\x9a\x94\x2d\xb3\x0a\x8c\xac\xd2\xc7\x93\x15\x65\xf3\x27\xad\x82\x35\x0e\x1a\x25\x06\x8c\xd4\xd1\x22\x8d\x94\x13\xf4\x36\x32\xda\x8a\xb5\x6a\xde\x0a\xcc\x59\x96\xf5\x6c\x27\xff\x57\x83\x3a\x67\x85\xf8\x11\xd2\x32\x44\x80\x98\x4a\x0b\xae\x89\x54\x13\x26\x04\x63\xe0\xcf\xea\xe4\x13\x5e\x3f\xae\x29\x31\x7f\xa1\xa1\x7c\x25\x3b\x20\xa6\x2c\x8a\x15\xa3\x4d\x16\xb0\x06\xf0\xad\xf8\x2e\x8d\xf1\xa2\x87\x4b\xe2\x48\x22\xe3\x08\x6b\xbf\xea\x4f\x42\x72\xee\xd3\x7b\x51\x1a\x5d\x2a\x9d\x42\x1d\xcb\x4b\x68\x30\x71\x80\xf1\xc3\xc3\x25\x75\x5d\xae\x94\x2a\xf0\x53\xab\x74\x43\xed\xab\x68\x12\x3f\x43\x1d\xb7\x6b\x33\x08\xf3\xec\x8b\x97\x20\xe2\x55\xcb\xce\x9b\xc7\xc6\xf0\x68\x70\x75\x49\xc6\xbd\x0f\xbd\xf1\xa4\x87\x45\x89\x06\x04\x54\xbe\x78\x8d\xd4\x98\x10\x27\x51\x53\x5f\xd0\xc8\x4a\xc2\xce\xec\x8a\xc2\x71\x82\xe2\x4a\x47\x83\x42\x55\x82\x78\xf1\xda\x38\x96\x8c\x5e\x86\x72\x23\xd1\xfd\x7c\xf0\xb3\x0e\x28\x95\x58\xe1\xa8\xba\x9e\x82\x4e\x43\xc7\xce\xdd\xdb\xe1\x70\x3a\xc6\x2d\x7e\x70\xdb\xed\x0c\x70\x0a\x54\x15\xae\x91\x60\x1b\xe7\xf8\x28\x15\x33\x46\x14\x09\xbb\xf8\xad\xd6\xaa\x18\x9b\xbb\xdf\x80\xa3\xbc\xb4\xd4\xf0\x54\x19\xd0\x36\x2b\xc7\x87\x4f\xf0\x9f\x34\x21\xdf\x87\x01\xb1\x8b\x03\x71\x00\x0b\xa2\xc0\x83\x0a\xca\xad\x67\xc0\xea\x80\xd2\x20\x4a\x53\xcd\xa2\xfc\xa0\x1a\x9c\x6c\x98\xbd\xe9\x5b\xf2\x76\xd0\x1b\x6a\xd7\xa7\xee\xac\x05\x2e\x6a\x8b\xd2\xf9\x7a\xcb\xae\x62\x78\x15\xed\xe4\x73\x15\x69\xf6\x8c\x5d\x43\x1b\x14\x4b\x49\xd5\x20\xc7\x53\xfa\x58\xc6\x1e\x2e\xbb\x02\x36\x79\xfe\x7e\x19\xf7\xa7\x3d\xbc\x7b\x3b\x46\x1e\x45\xbc\x82\x76\x82\x3b\xd7\x9b\xcf\x8f\xd3\xd5\x29\x2e\x1a\x70\x7e\x59\x98\x08\xbf\x9e\x99\x59\x0c\x5a\x5f\xbc\xd6\x1e\xe1\xe8\xc8\xb6\x52\x97\xec\x63\xc5\x38\xf2\xd3\x1c\xb5\x8c\x71\x55\xf6\x10\xf1\xca\x14\x3a\x46\x93\xde\xdd\xf5\x2d\x79\x7b\x3d\x4e\x05\x9b\xe7\xbb\x3b\xc1\x5a\x62\xb3\x55\x76\x76\xa7\xf5\x3a\x8c\x18\x9f\x87\x7c\x05\xfd\xd1\xc3\xa5\xb1\x3f\x18\x76\xa6\x30\xa3\x82\xb9\x10\x06\x85\x8b\x5e\xe0\xb2\x4f\x98\x8e\xfc\x07\xe5\xf7\x20\x96\x33\xcb\x4a\x52\x8b\xf4\x5a\xc2\xaa\x65\xe9\x65\x61\xfb\xae\x99\xde\x34\xfc\x17\xee\x82\xb3\x60\xce\x12\x85\x39\xf7\x98\xef\x16\x07\x2b\xfb\xb4\xb2\x11\xf8\x35\x1d\xa1\xb8\xb1\xac\xcd\x0c\x2a\x94\x5b\x96\xfa\x93\x5e\x54\xea\x48\x3c\xac\xd5\x9f\x63\x96\xa5\xfd\x0b\x68\x60\x65\xfd\xe7\xc8\xa5\x98\x6a\x61\x66\x85\x9a\x04\x11\xc6\xdc\x49\xc7\xed\x93\x0a\x7d\xed\xed\x42\xc7\x52\x6a\x99\x87\xed\x1d\x49\x24\xb5\x79\xe3\x38\xc4\xc3\x93\xc1\xed\xed\xbb\xbb\x11\xee\x4c\xc8\x47\x02\x6b\x24\xb4\xa3\x7a\x94\x4d\xd9\x80\x23\xf5\xac\xb1\x07\xf5\x70\x1b\x86\x77\x83\x41\x0e\xbd\x67\x39\xc8\x96\x36\xdf\x6a\xb3\xd0\x8b\x56\xa8\x51\xbb\x4e\x2d\x05\x7a\x2f\x49\x5e\x12\xa0\x0f\xae\xb0\x84\x05\x73\x1e\xae\x74\x6e\xbc\x15\xd4\x95\x38\x32\x48\xbf\xcd\x63\x79\xc1\xa8\xcb\x78\x3a\x06\x9b\x28\xd6\x28\xf4\x02\x2c\x1b\xab\xd8\x3c\xe4\xcc\xcc\xee\x05\xe9\x38\xb3\x82\x8a\xb1\x65\xfb\xd0\xb1\xa9\x0a\x49\x8d\x39\x20\x9d\xde\xa7\x88\x39\x52\xa8\x40\x4a\x2c\x67\xba\xff\xad\x2a\xb9\xd4\xd4\x0b\x5c\x8f\x33\x47\xea\x15\x03\x75\x1c\x44\x7b\x1c\xe1\xd8\x41\xf3\x04\x6e\x3c\xdf\x47\x72\x69\xd9\x32\xf5\x01\x83\xa6\x09\xea\xcd\xb9\x06\x5d\xdd\x50\x3d\x7f\x80\x17\x30\xed\x92\x4e\x77\x4a\x6e\xdf\xa1\xcb\x10\xb1\x22\x3d\x8f\x7d\x93\x33\x7a\x61\x60\x46\x96\xa2\x30\x2c\x8d\x68\x86\xf4\x31\x89\x19\x03\xef\x3e\xc0\xc5\x1f\x0b\x16\xc8\x34\xa7\xa9\x19\x0a\x43\x76\x4f\xa5\xf7\xc0\x80\x71\x1e\x72\x70\x42\x97\xed\x67\x8b\x26\x81\x5d\xb2\xa7\x4d\xf6\xb8\xcd\x98\x36\xe4\xb2\x1b\x6b\xbd\x85\xbe\xc6\x06\x7b\x46\x2e\x5d\x8f\xaf\x87\x6d\x75\xbd\xd3\x6e\x88\x3f\xf4\x9e\x67\x36\x72\xb3\x2d\xab\xd0\x40\xdf\x3b\x76\x3d\x8e\xb5\x88\xee\x94\xf4\x87\x6f\xc6\xbd\xc9\xa4\x06\xff\x5e\x8c\x0d\xe0\x55\xfa\xdd\x35\x24\x92\x9d\xbd\xbc\xff\x2e\xd9\x53\xba\xf3\x36\xc0\xc4\x15\x66\xb7\x4a\x30\x49\x68\xe0\x12\x85\xc9\xe3\x72\xc0\x66\x16\x5c\xcb\xca\x91\x78\x68\xa3\x69\x5b\x8a\xb0\xaa\x43\x65\xa1\x86\x7e\xac\x95\x1a\xff\x5a\xe5\xa1\x2a\x28\x34\xfa\x38\x52\x24\x5d\x1d\x6e\x6a\x99\x68\x42\x67\xe9\x0a\xd7\x82\xfd\x9c\x1a\x75\x96\x02\x75\x34\x04\xed\xcd\xf4\xb5\x0d\xb8\xc9\x65\x55\x15\x70\xc8\x35\x5f\xf4\xaa\xd1\xe7\xe5\xd8\x2c\x56\x5f\x73\x0c\x88\x07\x07\x7d\xe0\x76\xcf\x9c\x78\x63\xf2\xbe\x33\x99\xf6\xc6\x0d\xc8\xab\x4a\x45\xdf\x05\x79\x58\x96\x78\x70\x76\x38\x6a\x3d\xbd\x28\xd5\x72\x1f\x1c\x55\xcb\x7d\x70\xd6\x6a\xb9\x5a\xba\x86\x5b\x3c\xd0\x82\x45\x46\x73\x82\x87\x9a\xbc\x2f\x77\x18\xc8\xf7\x73\xd5\x47\xcc\xf1\x32\x99\xa8\xeb\x3a\x63\x2f\xc0\x03\xbb\x7c\x9f\xc7\xff\xae\x05\x18\xa0\xa9\x15\x60\x42\x6b\xeb\xa9\x15\x8c\xf4\xd4\x59\x31\x3a\xa7\xcc\x8b\x64\x03\x3b\x2f\x55\xd0\x2b\x74\xb8\xbe\x94\xef\x48\xab\xf9\x4a\xfc\xfe\x6c\x93\x9b\x4e\x7f\x50\xe6\xfd\x65\xf9\xc2\x45\x2d\xb3\x74\x8c\x00\x2a\x32\xf1\x5d\xc6\xb9\xab\xeb\x62\x59\xd5\x96\x69\x02\x2a\x55\xbd\x2f\x87\x48\x65\x0d\x2a\xae\x4d\x0b\x41\xd7\xfb\xf7\x12\x86\xea\xf6\xea\x55\xab\xe7\xca\xda\xfb\x0c\xe5\x19\xdd\xfd\xa0\xa3\x26\x05\xbf\xbd\x99\x21\x93\xbb\x6e\xb7\x37\x99\x34\x12\xc5\x4b\xca\xef\x99\x54\xb0\x52\xdf\x53\x54\x95\x20\xb0\xae\x26\x0c\xad\x06\x61\xb8\x84\x38\x02\xaa\xbb\x4e\xa6\xfd\x62\xb6\xf5\xa5\x17\x45\x68\xf3\x2e\xa7\x9e\x3a\x09\xa1\x86\x25\xd5\xb5\x62\x7b\x41\x6c\x0d\xc3\x54\x34\x65\x94\xb2\x64\x4f\xd9\x60\xa4\x87\x2d\x2a\x6c\xc1\x31\x37\x61\x23\xbd\xab\x37\x08\xcb\x1a\x34\x53\x97\xb3\x16\xc5\x95\xf2\x15\xbc\x7f\x5d\x62\x57\xb5\x60\x70\x7a\x06\x4c\x48\x6a\x4e\xd5\xe6\xb8\x6f\x94\x97\x03\xfa\xfc\x25\x9e\xee\xc5\xd0\x2b\xe9\xac\xa0\x6c\x14\xcd\x24\x1e\x4c\x0f\xc1\x26\xe6\x67\xa2\x3c\x75\xa9\x28\xb2\xaa\xee\xcb\x0e\xcb\xc0\xd8\xeb\x81\xed\x63\x20\xd6\x6e\x03\xc9\x0d\x2d\x14\x7a\x73\xe1\xd0\x2e\x1b\x42\xd8\xfe\xa0\x3c\x71\x1b\x72\x6c\x6a\xfe\xd0\x76\xd4\xa4\xea\x7b\x2d\x9f\x81\x23\xc8\x0d\x81\x38\xf0\xbd\x25\xf3\x9f\x8e\x33\xaf\x55\x43\xcf\x5e\x6e\x3d\x21\xb9\x94\x47\xcd\x5f\x2d\xf5\x95\xdb\xa6\xcf\xe8\xe6\x52\x10\xe5\x08\x9f\xed\xdd\x71\x66\x52\xba\xac\x96\x36\x14\xa3\x3c\x64\xd6\x15\xb2\xaa\x72\xae\x4f\x03\x29\xd9\x5e\x64\xd1\x62\x29\xec\xac\x8e\x2b\xf3\x04\x2a\xb5\xba\x41\x45\x4a\x7d\xb9\x9a\x89\x5b\xac\x99\xb8\x42\xd7\x5f\x1a\x9a\xa5\x9a\x51\x89\x99\x19\xd5\x50\x2e\x6e\x24\x5b\x63\x56\x56\x2c\x54\x4d\x0a\x5b\x41\xb1\x70\x92\x9b\x2d\x2d\x9e\x64\x93\x7d\x85\x22\x49\x75\x95\xc4\x7a\x2e\x6c\xf0\x3a\x5a\x4b\xfd\x22\xae\x30\xf3\xde\x18\x42\xe4\x6f\x25\x55\x1c\xd3\xaa\x80\x76\x3b\x2d\x50\x63\xff\xe0\xb7\xdf\xa0\xe2\xce\xdd\xf5\xa8\x66\x64\x84\x9b\x91\xea\xdc\x65\x54\x5b\x76\xb1\x4b\x71\x78\x93\x62\xbf\x1e\x41\xae\x99\x95\xc9\x50\x35\x1a\x8b\x9b\xd5\x3e\xd5\xd5\x34\x56\xd0\xd7\xd3\xf4\x70\x3f\x03\xf2\x43\x87\xfa\x9b\x0c\x68\x83\x41\x54\x40\xbe\xda\x36\x2a\x0d\xa2\xe2\xe9\xea\x82\xca\x06\xc3\xa9\xb2\x38\x3c\x0e\x22\xa9\x44\xe7\x87\x7f\x4c\x47\xdb\x78\xd3\x56\x75\xc6\x67\xe7\x4e\x46\x94\xcf\x1c\x55\x38\x28\xfd\x59\x1f\xe3\x68\x6d\xf4\xb0\xc5\x8d\x20\x23\x84\xdf\x37\xe5\x52\x05\x07\xac\x8c\x64\x78\x4b\x26\xbd\xf1\x87\x7e\xb7\x97\x25\x72\x6b\xb9\xe9\x5a\xed\x36\xcb\x51\x1b\x70\x94\x0b\x38\x6a\xad\x34\x59\xad\x4c\x64\x37\x10\x72\xf7\xa2\x54\x4c\x68\xb5\x11\x27\x7a\xc0\x38\x9d\x4a\xf6\xe2\xb5\x2e\x27\x11\x55\x8b\x32\x36\x57\xb8\x98\x73\xff\x39\xcf\x8e\xe2\x43\xf2\x15\x82\xd3\xc0\xcc\x81\x32\xe7\x91\x8c\xee\x6a\xbb\xcd\x80\x98\x23\xca\x3b\xab\x87\x1b\x4c\x61\x47\x25\xb1\x38\xc6\x8b\x1e\x9a\x5b\x8a\x87\x25\x8a\xa5\x23\xcd\xaa\xf1\x54\x9e\x34\xc5\xbe\xf9\xa4\xe1\xaf\x36\x01\x55\x2a\xf7\x22\x5d\x1f\xc7\x0f\x58\xdf\x4a\xb2\xac\xfd\x3a\x4e\xcd\xcd\x1d\xa7\x5c\x7b\x28\xdd\x9e\xb2\x2b\xdf\x69\xbb\x49\x4b\x2c\xab\x91\xe8\x4e\x87\x95\xfb\x0a\x6d\x23\x4f\x68\x17\x24\x92\x1a\xd4\x3a\x19\x51\xa2\x23\x76\x12\x5a\xeb\xfc\x24\xbb\x4b\xe5\x21\x96\x0b\x7d\x88\x25\x41\x5f\x76\x44\xda\x8b\x54\x48\xa6\x26\xab\x99\xc6\x10\xc2\xa0\xb9\x97\x3c\x4d\xe3\xa7\x82\xbf\x54\xd4\x06\x76\x2f\x5e\xfb\x61\x18\x61\x25\x22\x3d\x32\x36\xcd\x72\x93\x47\x2a\xf0\x90\x54\xc4\xdc\xb4\x58\xa1\xb6\x4e\x74\x1a\xc1\x3d\xb0\xc0\x8d\xb0\x22\x8b\x45\x4d\x7c\x09\xcc\xb2\xa0\x6e\xde\x22\x4a\x6b\x1a\xb9\xb7\x86\x4e\x92\x77\xdd\x92\x37\xd0\xb0\x16\x4b\xa5\x0a\xe3\x43\xee\xdd\x7b\x01\xf5\x13\x02\xc5\x36\x82\x09\xf5\x35\x47\x9e\x2e\xe1\x56\x8d\x49\x1e\x77\x62\xce\xb1\x2e\x9a\x3c\x70\x02\xbf\xa8\xed\x9e\xb3\x39\x96\x4c\xcd\xbb\x4f\x2b\x7c\x93\x08\x09\x99\xd1\xc9\xa4\x09\x05\x43\x15\xef\x63\xc6\xe2\x32\x21\xbd\x40\xad\x20\xbd\x57\x3f\xcd\x62\x36\x44\x8d\xeb\xa9\x13\xbb\x5f\xa0\x6d\x37\xaf\x6d\xf7\x20\x6d\x6f\x75\x04\x83\xdb\xdb\xd1\x15\x6e\x3f\x13\x3c\x11\x3f\xee\x7d\x68\x18\x86\x93\x0f\xc2\x8b\x6a\x25\xd6\xd7\x8e\x11\x1f\xc4\xbb\x50\xbc\xe7\x03\xd2\x0d\xec\x57\x75\x0d\xd7\xda\x86\x39\x71\x54\xd0\x4f\x8e\xf9\xdc\xa9\x4e\x14\xaa\xb9\xdc\xaa\x5a\x78\xce\x22\x39\xab\x1e\xe1\x71\xf5\x00\x5f\x46\x53\xe3\x36\xe8\x74\xa3\x83\x29\xfa\x11\xcd\x46\xe6\x38\x4a\xee\x21\x77\x5f\xfb\x03\xdb\xd6\xc1\x0f\x7c\x81\x98\x53\x87\xa0\xcd\x3b\x13\x73\x85\x94\xb7\x08\xb9\x52\xc6\x86\x5e\x81\xb8\x88\x57\x86\xbc\x7f\x41\xd4\x13\xc5\xac\x62\x07\xc7\xaa\x39\x53\xcb\x72\x8d\xb3\x2d\x1d\xd8\x8b\x96\xfd\x0f\xd4\xfd\x2d\xb7\x7f\x9b\x5f\xa5\xfd\x7b\xb1\xd6\xfe\xbd\xd8\x55\x6b\x29\xb5\xc0\xf2\x77\x4b\xbd\xaf\xbf\x63\x6f\x78\x67\xf7\xe8\xdb\xc5\x62\xeb\xa1\xd3\x61\xc1\x5a\xb1\x04\xd3\x3c\xb4\xd3\x5b\x88\xac\x74\xd1\xb0\x91\x32\x55\x0a\x8f\xf7\xec\xfd\x16\x98\x68\xc0\xd1\x06\x6a\x87\x75\x83\x73\x1a\xc9\x2c\xb5\xca\x5c\xd6\xba\xc2\xd9\x3b\x45\x86\x91\xca\x3e\x71\xf3\x8f\xd0\x27\x06\x2f\xf8\x7e\x5b\xb6\xcd\xc3\x5a\xb6\xeb\x56\xb1\xab\x65\xdb\xfc\xb2\x96\xed\xa6\xdd\x34\x5f\xd4\x2a\x87\xec\x7a\x47\x2d\x0d\xa9\x6e\xd6\x16\x76\xdd\x57\x50\x99\xd9\xfe\x31\x9b\xb0\xe9\x1b\xa4\x58\xcd\x6e\x9a\x6a\xb6\xbe\xb6\xbd\x90\x0d\x50\xfd\x8e\xcd\x81\x5d\xd8\xe6\x5a\xed\xda\xca\x5e\xd4\xc7\x14\xe2\x81\x71\x6f\xee\x31\x0e\x33\xca\x97\x42\xa7\x08\x82\x81\x43\x7d\x5f\x40\xf2\x23\x21\x41\xf8\x88\xf9\x02\x88\x70\xc5\x80\x33\x2a\xc2\x20\x29\xc9\x9d\xd6\x61\xab\x0f\xde\xd2\xfd\x2b\xd6\xe3\xf4\x8b\x2d\x3b\x1a\x48\xcd\xf5\x06\xd2\x77\xd5\xd3\xad\xe2\x7f\xd7\x02\xbe\x7a\x4f\xb7\x02\x11\xeb\x4b\xf9\x7f\x8b\x91\x7c\xd5\x77\x7f\xb6\x75\x87\xf8\x4c\x07\x12\xe5\xf6\xe2\x61\xde\xe1\xb0\x2e\x70\xb3\xba\xc9\x55\x0e\x9f\xca\x3a\x3f\xb4\x0b\xdc\xfc\x6a\x5d\xe0\x0a\x05\x1d\xda\x05\x6e\xee\xee\x02\xeb\x2f\x86\xa9\xb2\x8e\xff\xd9\x02\xfe\xc7\x6d\x01\x57\x9b\xc5\xe7\xb5\x80\x37\x58\xc7\xc1\x2d\xe0\x75\x03\x2a\x36\x28\xca\xd6\xfb\x8d\x5b\xc0\x9b\xa7\xcf\xe8\x1e\xd8\x02\xb6\x71\x51\x5b\x5b\xc0\xba\xac\xa6\xde\xba\xd0\xcd\x8c\xfc\x25\x55\x05\x69\x60\x27\xd1\x5c\x4d\x8f\x96\x97\xfa\xc4\x9b\x93\xce\x54\x93\x1b\x82\xd8\x2a\x3d\xe7\x9e\x2a\xeb\xad\xf8\x92\x9e\xa9\x0b\xaa\x57\x44\x6c\xeb\x0b\x0a\x3e\xa6\xae\x96\x93\x44\xbe\xe2\x83\x94\x2b\x4a\x1a\xbb\xaa\x3d\xc5\x9d\xb0\x59\x45\x5e\x27\x9a\x46\xda\x18\x43\x64\xb2\xdf\xcf\x8d\x66\x45\xc7\x46\x41\x43\x39\x3a\xda\x7f\x7e\x69\x39\x2c\x25\x98\x49\xa7\x2c\x9e\x83\x4a\x8e\x39\x66\x9b\x65\xea\xba\x20\xf6\x6c\xff\xde\x45\xb1\x5a\xfa\x5a\xc9\xd7\xaa\x8a\x7d\x3f\xed\xfe\xef\xbf\xc5\xaf\x25\xda\x1b\x76\xae\x06\x3d\xd2\x1f\x7d\x68\x6e\xf5\x99\xda\x13\x7f\x93\xae\x7f\xc1\xe3\xad\x3d\x5d\x5d\x5c\xdb\xe0\x16\x2b\xa7\x2f\x76\xfd\x53\xd7\xae\x0c\x2e\xdf\x04\x4d\xed\x0e\x53\x87\x9c\x8f\x6a\x95\x4e\x09\xe4\x0f\x04\x94\x7f\x67\xa2\x62\xbb\xda\x71\x20\x60\x43\x58\xf0\x55\x0e\x04\x1c\xd2\x56\x37\x63\xb5\x00\xd2\xd5\x9b\xe1\xc9\x91\xc4\x56\xf6\x8b\x7a\xd7\xfd\x89\xc2\x52\xea\x79\x07\x57\x36\xa6\x48\x93\x88\x39\x1e\xf5\xc1\xb4\xf9\x00\x5f\x0b\x4d\xb2\xaf\xa4\xed\x96\x75\xf1\x16\x14\x7f\x8e\x81\x06\x62\xe5\x49\x1d\x88\x51\x1b\x1b\x62\x46\xa7\x69\x3b\x65\xc6\x30\xe2\x4a\x5f\x4f\x2d\x75\x07\x55\x2b\x4d\xf7\xfa\x1e\xf1\x9d\x05\x45\x84\x33\x11\xfb\x12\x23\x39\x6a\xe2\x5e\xf5\xe6\x90\xe7\xb2\x40\x7a\x0e\xf5\xf5\x83\xea\xd5\x8d\x8a\xf6\xcc\x89\xa2\x32\xf0\x82\xf8\x53\xfa\x53\x0c\x02\x44\xec\x2c\x34\x39\x81\x3f\xe7\xb9\xa2\x5c\x7a\x34\xc8\xd3\x52\xef\x2b\xb9\x3c\x8c\x20\x0e\x7c\x26\x84\xe6\x46\xff\xce\x64\x88\xfc\xa4\xc2\x71\x19\x82\xf7\x24\x2d\xfc\x63\x61\x3c\xa9\x5c\x94\xdf\x6e\x42\x22\xa6\x91\x88\x3f\x48\x1a\x45\x8c\xf2\xe4\x95\x19\x1a\x40\x18\x4b\x64\xb0\xc0\xfb\xa9\x76\x9d\x78\x0d\xda\x05\x3d\x26\xce\x3c\x8f\x78\x74\x09\xe9\x2e\xda\xca\x8e\x64\xa4\x9c\xb6\xe1\x3c\x77\x59\x3f\x95\x52\xc8\xdd\x12\x0f\x0e\xd1\xb7\xcd\xad\x9c\x77\x42\x8e\x7e\x28\xd1\xde\xda\x69\xd7\xc8\xaf\xc2\x62\xa9\x19\x56\x89\x57\x3d\x71\x3e\x53\xca\xc5\x83\x68\x66\x47\x29\xd6\x4d\x2f\xca\x9c\x67\xd1\x71\x9f\x65\xad\x9b\x9f\xf6\x7c\xc6\x99\x67\x6e\xcd\xb8\xb1\xf4\x58\x89\x59\x79\xf2\x17\x4e\xeb\xe6\xb7\x27\xa1\x7e\x6a\xff\xdf\x00\x45\x6e\x0c\xd5\xc6\x56\x00\x00")
 
 func bpfLibLbHBytes() ([]byte, error) {
 	return bindataRead(
@@ -978,7 +1000,7 @@ func bpfLibLbH() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "bpf/lib/lb.h", size: 19293, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
+	info := bindataFileInfo{name: "bpf/lib/lb.h", size: 22214, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1023,6 +1045,26 @@ func bpfLibMapsH() (*asset, error) {
 	return a, nil
 }
 
+var _bpfLibMirrorH = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x56\xdb\x6e\xe3\x36\x10\x7d\xb6\xbe\xe2\x74\x83\x2e\x6c\xaf\xd7\x97\x6c\x2f\x40\x5d\x07\x50\x5c\x7b\x57\x80\xe3\x08\xbe\x74\x9b\x27\x82\x96\x46\x11\x61\x89\x14\x48\x2a\xa9\xbb\xd8\x7f\x2f\x28\xf9\x92\x78\xdd\xf6\xa5\x79\x51\x48\xce\x39\x73\x39\x33\x03\xf7\xda\x1e\xda\xc0\x58\x15\x3b\x2d\x1e\x53\x8b\xe6\xb8\x85\xeb\xfe\xe0\x67\xf8\xa5\x4d\x95\x36\x50\x09\xc6\x22\x13\x65\xee\xa1\xb6\x5d\xa5\xc2\xa0\xd0\xea\x51\xf3\x1c\xc2\x20\xd1\x44\x30\x2a\xb1\xcf\x5c\xd3\x10\x3b\x55\x22\xe2\x12\x9a\x62\x61\xac\x16\x9b\xd2\x12\x84\x05\x97\x71\x4f\x69\xe4\x2a\x16\xc9\xae\x22\x12\x16\xa5\x8c\x49\xc3\xa6\x04\x4b\x3a\xaf\x9c\xb9\xc3\xc7\xf9\x1a\x1f\x49\x92\xe6\x19\xc2\x72\x93\x89\x08\x33\x11\x91\x34\x04\x6e\x50\xb8\x1b\x93\x52\x8c\x4d\x4d\xe4\x20\x53\x17\xc5\x72\x1f\x05\xa6\xaa\x94\x31\xb7\x42\xc9\x21\x48\xd8\x94\x34\x9e\x48\x1b\xa1\x24\xae\x0f\x4e\xf6\x8c\x1d\x28\x5d\xb1\x34\xb9\x75\xc1\x6b\xa8\xc2\x01\x5b\xe0\x72\x87\x8c\xdb\x13\xb6\xfb\x4f\x25\x38\x65\x1a\x43\xc8\x2a\x9f\x54\x15\x04\x9b\x72\xeb\x72\x7f\x16\x59\x86\x0d\xa1\x34\x94\x94\x59\xa7\x72\xb7\x29\x2d\x3e\x07\xab\x4f\xf7\xeb\x15\xfc\xf9\x03\x3e\xfb\x8b\x85\x3f\x5f\x3d\x0c\xf1\x2c\x6c\xaa\x4a\x0b\x7a\xa2\x9a\x4b\xe4\x45\x26\x28\xc6\x33\xd7\x9a\x4b\xbb\x83\x4a\x2a\x8a\xbb\xc9\x62\xfc\xc9\x9f\xaf\xfc\xdb\x60\x16\xac\x1e\xa0\x34\xa6\xc1\x6a\x3e\x59\x2e\x31\xbd\x5f\xc0\x47\xe8\x2f\x56\xc1\x78\x3d\xf3\x17\x08\xd7\x8b\xf0\x7e\x39\xe9\x02\x4b\x72\x81\x51\xc5\xf0\x2f\x85\x4e\x2a\xb1\x34\x21\x26\xcb\x45\x66\x8e\xc9\x3f\xa8\x12\x26\x55\x65\x16\x23\xe5\x4f\x04\x4d\x11\x89\x27\x8a\xc1\x11\xa9\x62\xf7\xdf\x1a\x56\x2c\x3c\x53\xf2\xb1\x4a\x15\xf6\x45\x35\x87\x10\x09\xa4\xb2\x1d\x3c\x6b\x61\x09\x56\x7d\xab\x6e\x85\x3f\x29\xdc\x41\x20\xa3\x6e\x07\x3f\x0e\x30\xd5\x5c\x6e\x33\x21\xb1\xb4\x1d\x4c\x45\x62\x53\x4c\x33\xa5\x74\x07\xb7\xca\x58\x67\x7a\xe7\x03\xfd\xeb\xc1\xa0\xff\x7e\xf0\xa1\x3f\x00\xd6\x4b\xdf\x43\xbb\xe7\xd5\x73\xb0\xd2\x3c\x49\x44\x84\x5c\x68\xad\xb4\x90\x8f\xce\xbf\x4b\x2b\xcb\x28\xb2\x4a\x43\x48\x4b\x3a\xe1\x11\xed\x8b\xe1\x87\xc1\x2f\xee\xfb\xa4\x44\xbc\x47\xb1\x82\x47\x5b\xb2\x4d\xb3\xdd\xb4\xf6\x56\xe3\x4c\x49\x32\x55\x22\xf5\xa3\xa3\xbd\x0b\x16\x8b\xfb\x05\x0b\xa6\xc1\xfc\xb7\xc9\x1f\x5d\xdc\x4b\x82\x53\x5d\x25\x87\xa7\xa5\x7f\x17\xce\x26\x6c\xe1\xaf\x26\xce\x47\x0d\x35\xae\xdf\x6a\x4f\xae\xe4\x32\x06\xb7\xc8\x95\xb1\x07\x94\x33\x67\xb3\xe0\x2e\x58\x1d\x11\x6e\x22\x0e\x90\x8a\x89\x34\x0c\x45\xca\x81\x65\x8c\x71\xb8\xee\xc2\xbf\x80\x57\x09\xfa\x88\x85\xe1\x9b\x8c\x0c\x34\xb7\x84\x4c\xe4\xc2\x0a\xf9\x78\xe8\x86\x55\x4a\x88\x5c\x7a\x2e\x2c\xa9\x2c\x48\x46\xbc\x30\xa5\x9b\x9b\xb8\x73\x96\x24\xf2\xd2\x58\x37\x07\x1c\xb6\x94\x92\x32\xc4\xf4\x24\x22\xa7\xb2\xe3\xd2\xc4\xa3\x14\x1c\x9a\x72\x65\xe9\x54\xf5\x83\xaf\x20\xc1\x64\xee\xdf\xce\x26\xac\xa6\x3d\xb8\x8c\x29\x11\x92\xe2\x4e\x55\x60\x3f\x0c\x8e\xe3\x16\xa9\xbc\x10\x59\x3d\x93\xdc\x80\x63\x7e\x1f\x3a\xb2\x9e\xe7\x5d\x89\x44\xc6\x94\x80\xb1\x59\x70\xbb\xe7\x63\x9f\x98\x77\x55\x93\x7d\x73\xef\x5d\x09\x19\x65\x65\x4c\xf8\x75\x53\x24\x3d\x5e\x88\x6e\x7a\xf3\xe2\xf6\x4d\xa4\xf2\x5c\xc9\x6e\xfa\xc6\x5d\x26\x31\x9d\xc5\xea\x1d\x99\xf7\x9c\xc1\x7c\x35\x59\xfc\xee\xcf\xd8\x7c\x89\x41\xff\xf0\xb7\x9e\xcd\x3c\xcf\x58\x5d\x46\x76\xaf\x18\x33\xd6\xd5\xfd\x8b\xd7\x60\xac\xfc\xe9\x07\x18\xcb\xb5\x1d\x1e\x4e\x7b\x85\x87\xde\xd7\xe1\x11\xb7\x29\x12\x46\x59\xc2\x72\x5e\x80\x31\x43\x91\x1b\x12\x77\x32\x88\xaa\x45\xce\x6a\x66\x8c\xf0\xc5\x6b\x74\xed\xae\xa0\x46\x63\x84\xdb\x70\xca\xee\xfc\x90\xad\x1e\xc2\x09\x0b\x27\x8b\x71\xb8\x66\x6e\x25\x3d\x74\xbc\x46\xd7\x88\xbf\x88\x6d\x69\xd7\x18\xc1\xfd\xab\x92\x26\x63\xe5\x87\xeb\xd6\xf1\xed\x89\x67\x25\x9d\x5e\x2f\xa4\x50\xd9\x16\x42\x4a\x21\x1f\x1b\x23\x84\xc1\x9c\x7d\x9c\xdd\xdf\x56\x15\x70\x4f\x39\xff\x93\x51\x46\x79\x63\x84\x41\x67\x9f\x0f\xb7\x22\x82\x90\x99\x70\xfd\x25\x2d\x18\xab\x0f\x8c\x1d\xa8\x5d\x53\xb2\xaa\x29\x29\x6e\xba\x29\x6c\x79\x5f\x9c\x00\x17\xba\xf9\x06\x7d\xaf\x71\x21\x30\xb4\xab\x4f\x5d\xd3\x0f\xd7\xd8\xd2\x0e\x23\xf4\x8f\x35\x96\xea\x79\xe8\x39\xa4\xb3\x1d\x21\xe7\x05\xcb\x94\xda\x96\x45\x15\x6e\xf3\xed\xab\xa2\x76\xf0\x76\x4b\xbb\xd6\xd0\x6b\x88\x04\xcd\xef\x2a\x50\xcb\x6b\x34\x34\xd9\x52\x4b\x0c\x1c\x93\x54\xcf\x18\x61\x6b\x45\x4e\xec\x91\x2c\x93\xa6\x79\x00\xb8\xa7\xf7\x4e\x63\x4b\xef\x6f\x2a\xa9\x71\x73\xa1\x63\x5a\x4e\xb9\xc6\x2b\xb3\x51\x1d\xe7\xf1\xf6\x30\xfb\x75\x26\x5f\xbd\x9a\xff\xec\xf1\x66\xf4\x6d\x9d\xce\xa3\x7d\x0d\x79\xf7\x6e\xe8\x5d\x91\x8c\x45\xe2\x1d\xac\xfa\x43\xef\xeb\xb9\x58\x4e\x89\x0b\x6a\x1d\x56\x63\x2d\x02\x63\x66\xcb\x36\x65\x92\xa0\x5d\x2d\xcb\x57\xc2\xbd\x58\x7e\xb8\xc1\xa0\x0e\xdf\x55\xab\xd0\x5c\xc6\x2a\x77\x52\x35\x5b\xf8\xfe\x82\xfd\x29\x83\x63\xac\x35\xfc\x52\xcf\xb4\x5e\x5a\x7b\x8d\x6a\x97\x31\xf7\xdb\x45\x53\x54\x2d\xf1\xf3\x25\xd6\x41\xbf\x55\x65\x7c\x45\x99\x21\xf4\xda\x67\x4b\xa9\xdd\xfb\x9f\x8a\x51\xb9\x70\xd1\x5f\xf6\x71\x7a\x3b\xdb\x55\x68\xf7\xbc\xbf\x07\x00\x79\xa4\x76\x72\xd7\x09\x00\x00")
+
+func bpfLibMirrorHBytes() ([]byte, error) {
+	return bindataRead(
+		_bpfLibMirrorH,
+		"bpf/lib/mirror.h",
+	)
+}
+
+func bpfLibMirrorH() (*asset, error) {
+	bytes, err := bpfLibMirrorHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "bpf/lib/mirror.h", size: 2519, mode: os.FileMode(416), modTime: time.Unix(1450269211, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _bpfLibNat46H = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x9a\xef\x72\xe2\x38\x12\xc0\x3f\xc3\x53\xf4\xcd\xd4\xa6\x20\x4b\x08\x10\xaf\xf7\x6e\x08\xa9\x22\x60\x12\x6a\x08\x70\xc6\xcc\x4c\x6a\x6f\xcb\x65\xb0\x1c\xab\x62\x6c\xd6\x96\x9d\xe4\x66\xe6\xdd\xaf\x24\x61\x6c\xf9\x0f\x21\xb7\x7b\x7b\x3b\x1f\x52\x20\x75\xb7\x5a\xea\x56\xf7\x0f\x7b\xce\x4f\xab\x70\x0a\x30\xf0\xb6\x2f\x3e\x7e\xb0\x09\xd4\x06\x75\xe8\xb4\xda\xf2\x59\xa7\xd5\xfe\x19\xfa\x21\xb1\x3d\x3f\x00\xcf\x82\x01\x76\x70\xb8\xa9\x02\x57\xd0\x6c\x1c\xc0\xd6\xf7\x1e\x7c\x63\x03\x38\x00\xcb\x47\x08\x02\xcf\x22\x4f\x86\x8f\xba\xf0\xe2\x85\xb0\x36\x5c\xf0\x91\x89\x03\xe2\xe3\x55\x48\x10\x60\x02\x86\x6b\x9e\x7b\x3e\x6c\x3c\x13\x5b\x2f\xcc\x10\x26\x10\xba\x26\xf2\x81\xd8\x08\x08\xf2\x37\x6c\x31\xfa\xe5\x66\xba\x84\x1b\xe4\x22\xdf\x70\x60\x1e\xae\x1c\xbc\x86\x09\x5e\x23\x37\x40\x60\x04\xb0\xa5\x23\x81\x8d\x4c\x58\x71\x43\x54\x65\x44\xbd\x58\xec\xbc\x80\x91\x17\xba\xa6\x41\xb0\xe7\x76\x01\x61\x62\x23\x1f\x22\xe4\x07\xd8\x73\xa1\x13\x2f\xb2\xb3\xd8\x00\xcf\x67\x56\x6a\x06\xa1\xce\xfb\xe0\x6d\xa9\x62\x1d\x0c\xf7\x05\x1c\x83\x24\xba\xcd\xb2\x23\x48\x76\x6a\x02\x76\x99\x75\xdb\xdb\x22\x20\xb6\x41\xe8\x36\x9f\xb0\xe3\xc0\x0a\x41\x18\x20\x2b\x74\x1a\xcc\xc6\x2a\x24\xf0\x79\xac\xdd\xce\x96\x1a\xf4\xa7\xf7\xf0\xb9\xaf\xaa\xfd\xa9\x76\xdf\x85\x27\x4c\x6c\x2f\x24\x80\x22\xc4\x6d\xe1\xcd\xd6\xc1\xc8\x84\x27\xc3\xf7\x0d\x97\xbc\x80\x67\x31\x13\x77\x8a\x3a\xb8\xed\x4f\xb5\xfe\xf5\x78\x32\xd6\xee\xc1\xf3\x61\x34\xd6\xa6\xca\x62\x01\xa3\x99\x0a\x7d\x98\xf7\x55\x6d\x3c\x58\x4e\xfa\x2a\xcc\x97\xea\x7c\xb6\x50\x9a\x00\x0b\x44\x1d\x43\xcc\xc2\x81\x83\xb6\x58\xb0\x7c\x04\x26\x22\x06\x76\x82\xfd\xe6\xef\xbd\x10\x02\xdb\x0b\x1d\x13\x6c\x23\x42\xe0\xa3\x35\xc2\x11\x32\xc1\x80\xb5\xb7\x7d\x79\x3d\x86\xcc\x8a\xe1\x78\xee\x03\xdb\x2a\x90\xd4\x69\x76\x01\x5b\xe0\x7a\xa4\x01\x4f\x3e\x26\x08\x88\x97\x8f\x2e\xd3\x4f\x22\xdc\x80\xb1\xbb\x6e\x36\xe0\xa7\x36\x8c\x7c\xc3\x7d\x74\xb0\x0b\x0b\xd2\x80\x11\xb6\x88\x0d\x23\xc7\xf3\xfc\x06\x5c\x7b\x01\xa1\xa2\x77\x7d\x80\x56\xa7\xdd\x6e\x9d\xb5\x2f\x5a\x6d\x80\xe5\xa2\x5f\x85\xd3\xf3\xea\x7b\x6c\xb9\x26\xb2\x40\xd7\x27\xe3\x6b\x7d\xda\xd7\x24\x59\xd7\xab\xef\x4d\x64\x61\x17\x65\x46\xab\xef\xb1\xbb\x76\x42\x13\xc1\xa5\x83\xdd\xf0\xf9\x1c\x6f\x9b\xf6\x55\x7e\x74\xbd\x29\x1d\x8f\x64\x61\xe6\xdd\xda\xdb\x6c\x3c\xb7\x69\xbf\x4b\x8d\x61\x26\x95\x1e\x41\xc4\x16\x07\xcc\xd5\x03\x1d\xa0\xde\x03\x77\xd5\x04\x65\xda\xbf\x9e\x28\xdc\xd9\xa2\x89\xf1\xfc\x93\x04\x27\x27\xfb\xe1\xc1\x6c\x3a\xd5\xd4\xfe\xe0\xe3\x7e\xb7\x93\x2f\x83\x58\x1d\x39\x01\xaa\xbe\x7f\x32\x7c\x17\xbb\x0f\xf0\x2e\x6d\x1b\x7c\xf4\x5b\x88\x7d\x14\x24\x86\x23\x89\xde\xf2\xc4\xe2\xbb\xea\xfb\x90\x9d\x6a\xda\xa2\x6b\x62\x2b\x36\x5c\x32\x5b\x0d\x88\x41\xf0\x1a\xb0\xeb\x50\x7f\xb0\x4b\xe0\x01\x11\x7d\x1d\x84\x1b\xdd\xb3\xac\x00\x91\x9a\xae\x87\x7f\xa7\x39\x43\xbc\xb5\xe7\xd4\xab\x5f\xab\x15\x2a\x15\x4b\x74\xab\xd5\x4a\xf0\x84\xc9\xda\x86\xda\x5e\x08\xbe\x56\x2b\x6b\x23\x40\x30\x9e\xcf\xd5\x99\x36\xd3\xb5\xc1\xfc\x43\xb5\x52\x89\x95\xa0\x07\xda\x60\xae\x0f\x16\xcb\x3b\x7d\x36\x1a\x75\xab\x95\xca\xca\x47\xc6\x63\x37\xa3\xb6\x1c\x66\xd5\x96\xc3\x23\xd4\xc6\x83\xbb\xac\x5e\x8d\x6f\xc6\xb3\x6a\x01\xf1\xc3\x35\x01\x9a\x1a\xb6\xe9\x37\x60\x6d\xa3\xf5\x63\x10\x6e\xea\xf5\x83\xf6\x3e\xc9\xc7\x58\x94\x99\x49\xf6\x49\x2f\xb0\x6a\x22\xcb\x08\x1d\x42\x2d\xf9\x88\x84\xbe\x0b\x43\x75\x36\xd7\x97\xd3\x8f\xd3\xd9\xe7\xa9\x3e\x91\xba\xd5\xca\xf7\x6a\x35\x9e\x4c\xce\xf8\x7b\x51\xa0\xe8\x32\x92\x4e\x3c\x9d\xad\x17\xbb\xa1\xeb\xc1\xa3\xbe\x0a\x2d\x0b\x4e\x83\xc7\x55\x83\x49\xba\x36\x35\xc3\x62\x27\x6e\x9f\xdb\xe8\x0a\xc3\x72\x3c\x2e\x43\x0f\xbe\x7e\xa7\x01\xc6\x16\xd4\x82\xc7\x95\xee\x78\x86\xa9\xaf\x5e\x08\x0a\x6a\xcc\x36\xb7\xdb\x80\x13\x66\xa6\x01\x01\xfe\x37\xf2\xac\x1a\xfb\x56\xaf\xc3\x25\xb4\xea\x99\xad\x8e\xa7\x9f\xfa\x93\xf1\xb0\x5b\xad\xb0\xb4\xac\x54\xd8\x42\xcd\xd4\x89\x41\x8f\x3b\xd5\x8c\x03\x93\x64\x18\x37\xdc\x24\x2f\x5b\x94\x4a\xb1\xc1\xdd\x5c\x57\x06\xb7\xb3\x0f\x19\x6b\x54\x0c\x7a\xc0\x83\xc7\x24\x74\x55\xf9\xe7\x52\x59\x68\xdd\x8c\x24\x36\x91\x4b\xb0\x85\x91\xbf\x5f\x3c\x74\x9b\x68\x6d\x7b\x4d\x6c\x66\x85\x03\xf4\x5b\x88\xdc\x35\xca\x89\xc6\x13\xf9\x34\x8a\x3d\x54\x95\xf9\xe4\xfe\x28\x37\xe7\x93\xfb\xff\x87\x93\x43\x65\xa1\xe9\xcb\xa9\xaa\xf4\x07\xb7\x87\xfd\x4c\x4b\x52\x5b\x42\x80\xd6\x9e\xc9\x03\x94\x32\x3d\x55\x04\xcb\xc9\xc4\xed\x4c\x5c\x53\xcc\x08\xcf\x4c\x2d\x3a\x9d\xa9\xb3\xa5\xa6\xd0\xf5\xf6\xce\xa7\x2c\xd1\xcb\x5a\x6a\x49\x74\x7f\xde\x57\xfb\x77\x73\x75\x76\xdd\x3d\xbc\xe2\x72\xfa\x51\x9f\x2a\x5f\xb4\xdb\xa1\x9a\x93\xdc\x7a\xd8\x25\x2c\x1a\x72\xa9\x47\x33\xf5\xd8\xbd\xa5\x45\xcb\xcc\x8d\xd4\xfe\x8d\x3e\x55\x94\xa1\x32\x7c\x6d\x7f\x1f\x35\x5d\x9b\xcd\xae\xc7\x37\x65\x1b\x6c\xb1\x89\xf3\x53\x18\x8d\xbf\xdc\x29\xb4\x2f\x53\x39\x0b\x6a\xfb\x7c\xb1\x7c\xe3\xa1\xb9\x21\x21\xbd\xc2\xa2\x89\x0d\x09\xa1\x07\xab\xad\xa5\xdb\xc4\x73\x9d\x1a\xfd\xe4\x12\xcf\x0e\x0a\x94\x59\xfd\x8b\x2f\xfb\x61\x33\xed\x9f\x5a\xad\x7a\xd9\xde\x17\xaa\x3e\xea\x8f\x27\x05\x3b\x7f\x53\x8e\xf0\x34\x64\xe5\xb6\x30\x0d\xcb\x66\xc6\x8b\xd9\xa4\xaf\xf1\xd5\x73\x49\xad\x6b\xb3\x45\x71\x52\xc7\x33\xe5\x11\x28\xf1\xb1\x3f\x9d\x15\x58\xcc\x8d\xd2\x38\x8f\xc6\x13\x4d\x51\x5f\x3d\x98\xfe\xf0\x8e\xde\x90\xdb\xf1\xf5\x58\x53\x86\xe2\xda\xa9\xbe\x54\xd8\x98\xd8\x5a\x83\xd9\x90\x1d\xeb\xf7\xc2\xd2\xa1\x8d\xef\x14\x5d\xf9\x32\xd8\x67\x67\x79\x72\xa6\x44\x8b\xcb\x10\xbb\x9d\x8a\xa6\xa8\xf4\x86\x1e\xb6\x25\x5c\x64\x31\x9b\x0f\xdc\xd5\x23\xfb\x31\xdf\xd8\xfd\x5c\xd9\xb5\xe5\xb8\x0d\x06\xc4\xf3\x51\x69\x1f\x94\x85\x3e\x28\xd7\x1b\xd0\x2a\x6c\x85\x9f\xd5\xb1\xa6\xe8\x8a\xaa\xce\x54\xd6\x63\x85\xa6\xb7\xcb\x8f\xa2\xee\x48\xc7\xd3\x80\x60\x62\xcb\xaa\x15\x76\xe0\x03\x0e\x1d\x40\x0a\x39\x46\x0a\xe9\x77\x20\xc5\x0e\x1d\x8a\xb9\xe2\x68\xa4\xc8\x7a\x7e\x3c\x52\x08\x27\x99\x3b\xc5\x0c\x52\xa4\x53\x4b\x04\x8b\x0c\x38\x7c\xd8\x5b\x4f\x65\x21\x93\xe8\xee\x67\x92\xa6\x9c\x59\x39\x69\xe0\x79\xe1\x4c\xbf\xce\xb6\xf1\xc2\x6b\x22\xd0\x42\xb9\x67\x02\x4c\xfc\xb9\xee\x15\xe1\x84\xe8\xe0\x01\x8c\x48\x57\xb1\x0c\x4c\x24\x65\xfe\x43\x6e\x58\xd3\xa7\xca\xf8\xe6\xf6\x7a\xb6\x54\xb3\x93\xfd\xe1\x50\xcd\x75\x63\x4e\x2b\xb1\x3f\x69\x18\x29\x29\xcf\xb9\x52\x7a\xc8\x54\x7f\x3a\x2b\x37\x53\x48\x07\xa2\x91\x72\x2a\x38\xa6\x64\xcb\xe9\x9a\x2d\xac\xbc\xc7\x83\xe3\xc2\x92\x77\x2c\x85\x22\x05\x95\x77\x87\x11\xa9\x46\x5f\x4f\xf6\x97\xa2\x83\x54\xfb\x0f\xf6\x14\xe1\xe4\x74\x19\x14\xc4\x08\x71\xd8\xc8\x9e\x21\x8a\xf2\x31\xd5\x78\x8a\xf7\x2d\x34\xb1\xdc\xc6\xb3\x59\x59\xb6\xcc\xbe\x27\x7d\x78\x4b\x46\xdf\x0e\x55\x7d\x34\x56\x26\xe9\x7c\x4a\xfb\x26\x34\xc5\x6e\x2e\x5d\xca\x68\x42\x84\xd8\x12\xdb\xd9\x78\x17\x65\x62\x0a\xae\x7f\x67\x26\x1e\xd1\x75\xe5\xb7\xb7\xdd\x7c\xf3\xfb\xb3\xda\x6e\xbe\xbf\x96\x3a\x54\xd6\x76\xb7\x91\xac\x6f\x7d\x64\xe1\x67\x7d\x63\xd0\x7c\x89\xfb\xa6\x2b\xeb\x86\x69\xfa\x70\x4a\xff\x36\x18\x42\x03\x00\x84\x2e\xf6\x5c\x88\x64\x3e\x17\xc9\x5c\x97\x3f\x9c\xb1\xa0\x46\x87\xcf\xae\xa8\x72\xd8\x0c\xb9\x85\x8b\xce\x2f\xad\x5f\xa1\xd7\x83\x58\xf8\xec\x6a\xdb\x86\x93\x93\x2a\xb3\x57\xac\xd0\xce\x2a\x74\x5e\x51\xe8\x64\x15\x2e\x52\xa7\xdf\x4e\x3a\xf4\x6e\xa4\xc5\x8e\x83\x3f\x1c\xc7\xdb\x48\x02\xe2\xb1\xa3\x00\x7a\x42\xc8\x41\x41\x00\xae\x41\xe8\x6c\x2d\x90\x1a\xa6\x54\x87\xb3\x2b\xa8\x05\x72\xc3\x94\xeb\x74\x34\x90\xa1\x47\x25\xa4\xf8\xf0\x2e\x03\xe9\x8a\x4e\x98\xb9\x09\x53\xba\x02\xcf\x87\x48\xd6\xcd\x80\xf0\x07\x9f\x2e\xb8\xa1\xe3\xb0\x47\x92\x85\x11\xe1\x8f\x56\xb6\x51\xe9\x93\x95\x38\x46\x8c\x7a\x4e\xf1\x56\x62\xf1\x01\xfe\x2f\x61\xa4\x46\x2e\x5a\xd4\x07\x81\x9c\xb6\x11\x83\xa3\x48\xce\x62\x13\x33\x1d\x49\xdd\xec\x33\xb7\x8a\xae\xaf\xd0\x45\x87\x8d\xec\xbe\xb5\x65\x88\x24\xdb\xf4\x75\x07\xb9\xc9\x50\xfc\x48\x4e\x28\x92\x8a\x76\xab\xcf\xf5\xf1\xfc\x93\x5c\x67\x92\xa1\x2c\x71\xdb\x96\x63\x3c\x04\xd0\x83\xeb\xf9\x48\x1f\xe9\xf3\x85\xb2\x1c\xce\x74\xfe\xdb\x57\xd8\x43\xfa\x68\xa1\x07\xfc\x69\xed\x5c\x55\x46\xe3\x2f\xdd\x6a\xe5\x08\xb8\x8b\x92\xcb\x11\xbd\xf6\xa4\x88\x9b\x63\x01\xb1\x4d\xdf\x41\x6e\x0d\x6f\xa5\x3a\xfc\xad\x97\xb6\x50\xac\xac\xc7\x3f\xdd\xab\xb4\x43\xad\x42\xec\x98\xf4\x90\x6d\x64\x98\xc8\x67\xbd\x2a\x92\x9b\xf1\xdb\x8a\x1e\xb4\x9e\xe9\xaf\x83\x48\x6e\x06\x74\x97\xcd\xa2\xfb\x23\x6c\xbd\xb9\x6d\x1f\x92\x6f\xe7\xe5\x3b\x87\xe4\x3b\x79\xf9\x8b\x43\xf2\x17\x54\x3e\x92\xf8\x6c\x7c\x4e\xbb\xec\x62\xed\x25\x92\x9b\x66\xf9\x4e\xb8\x24\x2d\x03\xdd\x43\xb2\x6d\x41\xb6\x73\x50\xb6\x23\xc8\x5e\x1c\x94\xbd\x10\x64\xd9\x93\x4f\xa0\xe5\xe1\x08\xcf\xf3\x31\x78\xc5\xfd\x7c\x10\x5e\xd9\x43\x3e\x0a\xaf\x6c\x24\x79\x8a\x91\xe2\x18\xd1\x8a\x54\x87\x13\x68\x3d\x8f\x46\xa3\x51\xab\xd5\x6a\xd5\xe1\x1b\x2b\xe7\x95\x4a\x25\xa5\x12\x49\x7c\x95\x44\x96\xd1\x4f\xdc\x06\x23\xa9\x99\xdc\xe8\x9e\xf0\x80\xba\xce\x7d\x74\xd1\x33\xa1\x45\xa3\x97\x79\x7a\x9d\x14\x5f\x41\x28\x65\x90\xa7\x9a\xed\x6d\x75\x07\x6f\x30\xe1\x93\x84\xb0\xf1\xb8\xb0\x40\x8f\xf9\x80\x6d\x07\x2e\x2f\xa1\x53\xe7\x3a\x5b\xe3\x85\xdd\x74\x2e\x90\x27\xba\x80\xea\x10\x8f\x50\x81\x3a\x9c\x25\x75\xaa\x9e\xfe\x25\xb8\xb6\x0d\xf7\x01\xe9\xcc\x1d\x5e\x2e\x0a\x2b\xd6\xbe\xaf\xc3\xd7\xea\x7b\x6c\x99\xc8\x82\xa1\x72\xbd\xbc\xd9\xbd\xd7\xa8\x54\xb6\x3e\x76\xc9\x63\xed\x5d\x24\xc9\xb4\x32\x7d\x00\x6a\x9c\xbf\x0b\x05\xcb\xc0\x0e\x32\xff\xe5\xbe\xab\x77\xe3\x37\x20\x07\xe8\xe0\x48\xfa\x88\x12\x00\x88\x12\xff\xe0\xdb\x37\xde\x2a\x0f\x28\xc3\x19\x74\x1a\x70\x12\x47\xa0\x41\xbf\x1d\x47\x2d\xaf\xe4\x02\xa7\xcb\xd4\xd3\xf4\xd4\x6b\x82\xd4\xf2\x3f\xa6\xfc\x66\xd4\x1c\xab\x30\x1e\x09\x50\x68\x7a\x34\x52\x31\x1d\xd5\xd8\x56\xc5\xbc\xe2\x4c\x12\x77\xbd\x54\xc4\x85\xb4\xa8\x37\x58\x83\xa9\x8b\xb7\x7c\x9d\x90\x55\xfc\x39\x85\x56\x71\x65\x6b\x80\xc4\x0e\x39\xfe\xd6\x96\x13\x63\xc5\x6a\xa6\xa0\x66\x16\xa8\x1d\x38\xbf\xe5\x90\x5d\xa5\x4a\xaa\x21\x7e\x8b\x3b\xe2\x5d\x5f\xfd\xa8\xdf\xf5\xa7\x37\x13\x65\xa8\xb7\x76\x09\x72\x7e\x0a\xd5\x0a\x9c\xc2\x03\x22\xfb\x97\x49\x60\xf9\xde\x06\xb0\xeb\x22\x3f\xee\x36\x64\xbd\x85\x73\x08\x4d\xfa\x97\x46\x82\xe9\x84\xae\xe9\x71\xf4\xd9\x9f\x76\x62\xc3\x70\x4d\x26\x64\x98\x26\x70\x2e\xca\x0b\x51\x81\xf3\x6a\xfa\xbd\x54\xf6\xf5\x5d\x72\xdd\xe9\xce\xe9\xc6\xf7\xc2\x62\x9e\xa5\xe8\x62\x57\x29\xf6\x82\x3f\xee\x7b\xad\x48\x2c\xfb\xfb\xeb\x48\x7c\x4d\x1f\x6d\x1d\x63\x8d\x32\x49\x16\xdb\x69\x40\x8b\xc7\xa0\x91\xc2\x8d\xc2\x74\x67\xaf\xf8\x26\x52\xb7\x5a\x78\xc5\x73\x37\x7c\xb7\xd2\x0f\x66\x63\xbf\x16\xfc\x40\xaf\x79\x72\x4b\xe3\xf1\xd4\xc5\x2f\xa6\x50\x79\x47\xa1\x52\x11\x85\x32\xf4\xe4\x14\xca\x80\x94\x51\xa8\x04\x3d\xb8\xa4\x1a\x67\x3e\xad\x60\x57\xcd\x4b\xe7\x79\x7d\x86\x4d\x8e\xa2\x74\xd6\x94\x7f\xf9\x87\x0c\xcd\x26\xb4\x3b\x3f\xff\x5a\x4e\x9c\xf2\x8e\x38\x8f\x78\xf0\xd6\x80\x1d\x01\xb2\x4b\x51\x0c\x93\x79\x8e\x8c\xf1\xb2\x1c\x26\x77\xd7\xf1\x18\x7a\x3c\x9a\x1d\x8f\xe1\x41\xa1\x84\xbe\xc6\x83\xe7\xa7\x30\xf4\xbd\x2d\x58\xbe\xb1\x41\x01\x3c\xd9\x78\x6d\xc3\xda\xf0\xfd\x17\x40\xcf\x04\xb9\x14\xe7\x82\xdd\xbd\x0b\xd8\xfd\xd8\x11\xa4\x1c\x13\x64\x76\xf5\xfd\x15\x49\x63\xa5\xfc\x26\xac\x94\x04\xac\xe4\x3d\x92\x22\xe5\x4f\xac\x7f\x0a\x94\x29\xf1\x21\x16\x3a\xe8\xc1\x8e\xdf\x2a\x71\xe5\x62\x5c\x54\x0a\x1b\xdd\x98\xf3\x92\x2e\x9e\xed\xf5\x0c\x05\xd2\xd5\x4d\x98\x4f\x91\x80\x20\x93\x58\xe4\xbe\x10\xb2\x1b\xdd\x23\xc1\x6e\x9c\x37\xf2\xb2\x4e\x2f\xd6\xfd\x54\x8b\x91\x58\x8b\x49\x55\xaa\xdc\x0b\xf4\xd4\x0b\xf9\x58\x54\xa8\xed\xd3\xe5\x64\xc2\x6a\x48\xe6\xf7\xc3\xbe\xb0\xbf\x0d\x26\xfe\x8a\x28\x91\xd9\xd7\x9f\x84\x12\xce\xc5\x1b\xcb\x77\xb1\x65\x5e\xb5\x2f\x92\x9f\x22\x07\x52\x94\x01\x40\xaa\xf0\xb4\x33\x20\x90\x7d\x13\x51\x48\x2d\x52\x42\x2d\xed\xff\x0a\x5b\x2a\x07\x80\xa5\x95\x05\x0c\x38\xe3\x9e\xbe\x09\x62\x04\x6c\x11\x99\xe6\x10\xc4\x08\xd8\x22\x32\xcd\xff\x00\x62\xfe\x10\x86\x29\xc2\x93\x1c\xc3\x14\x81\xce\x11\x0c\x93\xec\xf2\x0f\x85\x98\xbf\x00\xc2\xc8\xd2\xef\x40\x18\x91\x61\xf8\x20\x9c\x9f\x8a\xff\x89\x8e\x1e\xee\x7f\x02\x00\x00\xff\xff\xee\x07\x43\x5e\x81\x2a\x00\x00")
 
 func bpfLibNat46HBytes() ([]byte, error) {
@@ -1331,6 +1373,7 @@ var _bindata = map[string]func() (*asset, error){
 	"bpf/lib/drop.h": bpfLibDropH,
 	"bpf/lib/eth.h": bpfLibEthH,
 	"bpf/lib/events.h": bpfLibEventsH,
+	"bpf/lib/flow.h": bpfLibFlowH,
 	"bpf/lib/geneve.h": bpfLibGeneveH,
 	"bpf/lib/icmp6.h": bpfLibIcmp6H,
 	"bpf/lib/ipv4.h": bpfLibIpv4H,
@@ -1340,6 +1383,7 @@ var _bindata = map[string]func() (*asset, error){
 	"bpf/lib/lb.h": bpfLibLbH,
 	"bpf/lib/lxc.h": bpfLibLxcH,
 	"bpf/lib/maps.h": bpfLibMapsH,
+	"bpf/lib/mirror.h": bpfLibMirrorH,
 	"bpf/lib/nat46.h": bpfLibNat46H,
 	"bpf/lib/policy.h": bpfLibPolicyH,
 	"bpf/lib/utils.h": bpfLibUtilsH,
@@ -1441,6 +1485,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"drop.h": &bintree{bpfLibDropH, map[string]*bintree{}},
 			"eth.h": &bintree{bpfLibEthH, map[string]*bintree{}},
 			"events.h": &bintree{bpfLibEventsH, map[string]*bintree{}},
+			"flow.h": &bintree{bpfLibFlowH, map[string]*bintree{}},
 			"geneve.h": &bintree{bpfLibGeneveH, map[string]*bintree{}},
 			"icmp6.h": &bintree{bpfLibIcmp6H, map[string]*bintree{}},
 			"ipv4.h": &bintree{bpfLibIpv4H, map[string]*bintree{}},
@@ -1450,6 +1495,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"lb.h": &bintree{bpfLibLbH, map[string]*bintree{}},
 			"lxc.h": &bintree{bpfLibLxcH, map[string]*bintree{}},
 			"maps.h": &bintree{bpfLibMapsH, map[string]*bintree{}},
+			"mirror.h": &bintree{bpfLibMirrorH, map[string]*bintree{}},
 			"nat46.h": &bintree{bpfLibNat46H, map[string]*bintree{}},
 			"policy.h": &bintree{bpfLibPolicyH, map[string]*bintree{}},
 			"utils.h": &bintree{bpfLibUtilsH, map[string]*bintree{}},
@@ -18,12 +18,16 @@ import (
 	"fmt"
 	"net"
 	"sync"
+	"time"
 
 	"github.com/cilium/cilium/common/addressing"
 	"github.com/cilium/cilium/daemon/options"
+	"github.com/cilium/cilium/pkg/flagalias"
+	"github.com/cilium/cilium/pkg/hooks"
 	"github.com/cilium/cilium/pkg/kvstore"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/maps/lxcmap"
+	"github.com/cilium/cilium/pkg/mirror"
 	"github.com/cilium/cilium/pkg/option"
 
 	log "github.com/Sirupsen/logrus"
@@ -70,6 +74,7 @@ type Config struct {
 	K8sCfgPath     string                  // Kubeconfig path
 	KVStore        string                  // key-value store type
 	LBInterface    string                  // Set with name of the interface to loadbalance packets from
+	LBDrainTimeout time.Duration           // Drain period of backends removed from a service
 	Tunnel         string                  // Tunnel mode
 
 	ValidLabelPrefixesMU  sync.RWMutex           // Protects the 2 variables below
@@ -93,13 +98,34 @@ type Config struct {
 	// StateDir is the directory where runtime state of endpoints is stored
 	StateDir string
 
+	// Mirror is the configuration of traffic mirroring to a collector
+	Mirror *mirror.Config
+
+	// Hooks is the configuration of the endpoint lifecycle hooks
+	Hooks hooks.Config
+
+	// ReadOnlyAPI is the address (unix:///path or tcp://host:port) of the
+	// listener serving the read-only subset of the API
+	ReadOnlyAPI string
+
+	// ReadOnlyAPITLSCert and ReadOnlyAPITLSKey are the certificate and key
+	// of TCP read-only API listeners. Client certificates are verified
+	// against ReadOnlyAPITLSCA if set.
+	ReadOnlyAPITLSCert string
+	ReadOnlyAPITLSKey  string
+	ReadOnlyAPITLSCA   string
+
+	// DeprecatedFlags is the list of deprecated flag names in use
+	DeprecatedFlags []flagalias.Alias
+
 	// Options changeable at runtime
 	Opts *option.BoolOptions
 }
 
 func NewConfig() *Config {
 	return &Config{
-		Opts: option.NewBoolOptions(&options.Library),
+		Opts:   option.NewBoolOptions(&options.Library),
+		Mirror: mirror.NewConfig(),
 	}
 }
 
@@ -42,6 +42,7 @@ import (
 	"github.com/cilium/cilium/pkg/container"
 	"github.com/cilium/cilium/pkg/endpoint"
 	"github.com/cilium/cilium/pkg/events"
+	"github.com/cilium/cilium/pkg/hooks"
 	"github.com/cilium/cilium/pkg/k8s"
 	k8sTypes "github.com/cilium/cilium/pkg/k8s/types"
 	"github.com/cilium/cilium/pkg/kvstore"
@@ -82,6 +83,7 @@ type Daemon struct {
 	kvClient          kvstore.KVClient
 	l7Proxy           *proxy.Proxy
 	loadBalancer      *types.LoadBalancer
+	lbSlots           map[types.ServiceID][]lbSlot // Protected by loadBalancer.BPFMapMU
 	loopbackIPv4      net.IP
 	policy            *policy.Repository
 
@@ -98,6 +100,11 @@ type Daemon struct {
 	maxCachedLabelIDMU sync.RWMutex
 	maxCachedLabelID   policy.NumericIdentity
 
+	mirrorMU      sync.RWMutex
+	mirrorIfIndex int
+
+	hooks *hooks.Dispatcher
+
 	uniqueIDMU sync.Mutex
 	uniqueID   map[uint64]bool
 }
@@ -440,15 +447,9 @@ func (d *Daemon) init() error {
 	f.Close()
 
 	if !d.DryModeEnabled() {
-		if err := d.compileBase(); err != nil {
-			return err
-		}
-		d.conf.LXCMap, err = lxcmap.OpenMap()
-		if err != nil {
-			log.Warningf("Could not create BPF endpoint map: %s", err)
-			return err
-		}
-
+		// The LB maps are opened before the programs using them are
+		// loaded so that pinned maps with an outdated layout are
+		// replaced first, see bpf.OpenOrCreateMap()
 		if _, err := lbmap.Service6Map.OpenOrCreate(); err != nil {
 			return err
 		}
@@ -469,10 +470,24 @@ func (d *Daemon) init() error {
 				return err
 			}
 		}
+
+		if err := d.compileBase(); err != nil {
+			return err
+		}
+		d.conf.LXCMap, err = lxcmap.OpenMap()
+		if err != nil {
+			log.Warningf("Could not create BPF endpoint map: %s", err)
+			return err
+		}
+
 		// Clean all lb entries
 		if !d.conf.RestoreState {
 			// FIXME Remove all loadbalancer entries
 		}
+
+		if err := d.initMirror(); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -581,6 +596,7 @@ func NewDaemon(c *Config) (*Daemon, error) {
 		endpointsAux:      make(map[string]*endpoint.Endpoint),
 		events:            make(chan events.Event, 512),
 		loadBalancer:      lb,
+		lbSlots:           map[types.ServiceID][]lbSlot{},
 		consumableCache:   policy.NewConsumableCache(),
 		policy:            policy.NewPolicyRepository(),
 		ignoredContainers: make(map[string]int),
@@ -588,6 +604,11 @@ func NewDaemon(c *Config) (*Daemon, error) {
 		uniqueID:          map[uint64]bool{},
 	}
 
+	d.hooks, err = hooks.NewDispatcher(c.Hooks)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint hook configuration: %s", err)
+	}
+
 	// Create the same amount of worker threads as there are CPUs
 	d.StartEndpointBuilders(runtime.NumCPU())
 
@@ -22,6 +22,7 @@ import (
 	. "github.com/cilium/cilium/api/v1/server/restapi/endpoint"
 	"github.com/cilium/cilium/pkg/apierror"
 	"github.com/cilium/cilium/pkg/endpoint"
+	"github.com/cilium/cilium/pkg/hooks"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/policy"
 
@@ -71,10 +72,13 @@ func (d *Daemon) insertEndpoint(ep *endpoint.Endpoint) {
 		id := endpoint.NewID(endpoint.DockerEndpointPrefix, ep.DockerEndpointID)
 		d.endpointsAux[id] = ep
 	}
+
+	d.updateMirrorCollector(ep.ID, ep.IfIndex)
 }
 
 func (d *Daemon) removeEndpoint(ep *endpoint.Endpoint) {
 	delete(d.endpoints, ep.ID)
+	d.updateMirrorCollector(ep.ID, 0)
 
 	if ep.DockerID != "" {
 		id := endpoint.NewID(endpoint.ContainerIdPrefix, ep.DockerID)
@@ -99,9 +103,19 @@ func (d *Daemon) SetEndpointIdentity(ep *endpoint.Endpoint, dockerID, dockerEPID
 	ep.Mutex.Lock()
 	setIfNotEmpty(&ep.DockerID, dockerID)
 	setIfNotEmpty(&ep.DockerEndpointID, dockerEPID)
+	previous := policy.NumericIdentity(0)
+	if ep.SecLabel != nil {
+		previous = ep.SecLabel.ID
+	}
 	ep.Mutex.Unlock()
 
 	ep.SetIdentity(d, labels)
+
+	if labels.ID != previous {
+		ep.Mutex.RLock()
+		d.notifyEndpointHooks(hooks.EndpointIdentityChange, ep, previous)
+		ep.Mutex.RUnlock()
+	}
 }
 
 func (d *Daemon) lookupEndpoint(id string) (*endpoint.Endpoint, *apierror.APIError) {
@@ -236,6 +250,10 @@ func (h *putEndpointID) Handle(params PutEndpointIDParams) middleware.Responder
 
 	h.d.insertEndpoint(ep)
 
+	ep.Mutex.RLock()
+	h.d.notifyEndpointHooks(hooks.EndpointCreate, ep, 0)
+	ep.Mutex.RUnlock()
+
 	return NewPutEndpointIDCreated()
 }
 
@@ -382,6 +400,7 @@ func (d *Daemon) deleteEndpoint(ep *endpoint.Endpoint) int {
 	}
 
 	d.removeEndpoint(ep)
+	d.notifyEndpointHooks(hooks.EndpointDelete, ep, 0)
 
 	if !d.conf.IPv4Disabled {
 		if err := d.ReleaseIP(ep.IPv4.IP()); err != nil {
@@ -0,0 +1,56 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"github.com/cilium/cilium/pkg/endpoint"
+	"github.com/cilium/cilium/pkg/hooks"
+	"github.com/cilium/cilium/pkg/policy"
+)
+
+// newHookEndpoint returns the representation of ep passed to the endpoint
+// lifecycle hooks. To be used with ep.Mutex locked.
+func newHookEndpoint(ep *endpoint.Endpoint) *hooks.Endpoint {
+	hep := &hooks.Endpoint{
+		ID:               ep.ID,
+		ContainerID:      ep.DockerID,
+		DockerEndpointID: ep.DockerEndpointID,
+		IPv4:             ep.IPv4.String(),
+		IPv6:             ep.IPv6.String(),
+	}
+
+	if ep.SecLabel != nil {
+		hep.Identity = ep.SecLabel.ID.Uint32()
+		hep.Labels = ep.SecLabel.Labels.GetModel()
+	}
+
+	return hep
+}
+
+// notifyEndpointHooks queues an event of type t for the endpoint lifecycle
+// hooks. To be used with ep.Mutex locked.
+func (d *Daemon) notifyEndpointHooks(t hooks.EventType, ep *endpoint.Endpoint, previous policy.NumericIdentity) {
+	if d.hooks == nil {
+		return
+	}
+
+	ev := hooks.NewEvent(t, newHookEndpoint(ep))
+	ev.PreviousIdentity = previous.Uint32()
+	ev.Node, _ = os.Hostname()
+
+	d.hooks.Notify(ev)
+}
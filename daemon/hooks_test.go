@@ -0,0 +1,59 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/cilium/cilium/common"
+	"github.com/cilium/cilium/common/addressing"
+	"github.com/cilium/cilium/pkg/endpoint"
+	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/policy"
+
+	. "gopkg.in/check.v1"
+)
+
+type HooksSuite struct{}
+
+var _ = Suite(&HooksSuite{})
+
+func (s *HooksSuite) TestNewHookEndpoint(c *C) {
+	ip6, err := addressing.NewCiliumIPv6("beef:beef:beef:beef:aaaa:aaaa:1111:1112")
+	c.Assert(err, IsNil)
+
+	ep := &endpoint.Endpoint{
+		ID:       4370,
+		DockerID: "foo",
+		IPv6:     ip6,
+	}
+
+	hep := newHookEndpoint(ep)
+	c.Assert(hep.ID, Equals, uint16(4370))
+	c.Assert(hep.ContainerID, Equals, "foo")
+	c.Assert(hep.IPv4, Equals, "")
+	c.Assert(hep.IPv6, Equals, "beef:beef:beef:beef:aaaa:aaaa:1111:1112")
+	c.Assert(hep.Identity, Equals, uint32(0))
+	c.Assert(hep.Labels, IsNil)
+
+	ep.IPv4, err = addressing.NewCiliumIPv4("10.1.0.1")
+	c.Assert(err, IsNil)
+	ep.SecLabel = policy.NewIdentity()
+	ep.SecLabel.ID = 256
+	ep.SecLabel.Labels = labels.Labels{"foo": labels.NewLabel("foo", "bar", common.CiliumLabelSource)}
+
+	hep = newHookEndpoint(ep)
+	c.Assert(hep.IPv4, Equals, "10.1.0.1")
+	c.Assert(hep.Identity, Equals, uint32(256))
+	c.Assert(hep.Labels, DeepEquals, []string{"cilium:foo=bar"})
+}
@@ -0,0 +1,210 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cilium/cilium/common/types"
+	"github.com/cilium/cilium/pkg/maps/lbmap"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// lbSlot is a backend slot of a service in the BPF services map.
+type lbSlot struct {
+	types.LBBackEnd
+
+	// drainUntil is the end of the drain period of a backend which has
+	// been removed from the service, zero for active backends
+	drainUntil time.Time
+}
+
+func (s *lbSlot) isDraining() bool {
+	return !s.drainUntil.IsZero()
+}
+
+func lbBackendKey(be *types.LBBackEnd) string {
+	return be.L3n4Addr.String()
+}
+
+// computeLBSlots returns the backend slots of a service after its backends
+// have been updated to bes. Backends retain their slot as long as they are
+// part of the service. Backends removed from the service keep their slot
+// until the drain period ends, new backends are appended. Returns true if a
+// backend has started draining.
+//
+// The datapath selects the slot of a connection by hashing over the number of
+// slots before the connection is looked up in the conntrack table. Established
+// connections are therefore only guaranteed to keep their backend as long as
+// the number of slots does not change, i.e. adding a backend or the expiry of
+// a draining slot may still move established connections.
+func computeLBSlots(old []lbSlot, bes []types.LBBackEnd, drainTimeout time.Duration, now time.Time) ([]lbSlot, bool) {
+	added := make(map[string]types.LBBackEnd, len(bes))
+	for _, be := range bes {
+		added[lbBackendKey(&be)] = be
+	}
+
+	// Draining requires an active backend to take over new connections
+	if drainTimeout == 0 || len(bes) == 0 {
+		old = nil
+	}
+
+	slots := make([]lbSlot, 0, len(bes))
+	started := false
+	for _, slot := range old {
+		key := lbBackendKey(&slot.LBBackEnd)
+		if be, ok := added[key]; ok {
+			slots = append(slots, lbSlot{LBBackEnd: be})
+			delete(added, key)
+			continue
+		}
+
+		if !slot.isDraining() {
+			slot.drainUntil = now.Add(drainTimeout)
+			started = true
+		}
+
+		if slot.drainUntil.After(now) {
+			slots = append(slots, slot)
+		}
+	}
+
+	for _, be := range bes {
+		key := lbBackendKey(&be)
+		if _, ok := added[key]; ok {
+			slots = append(slots, lbSlot{LBBackEnd: be})
+			delete(added, key)
+		}
+	}
+
+	return slots, started
+}
+
+// lbSlots2ServiceKeynValue converts the backend slots of svc to the BPF
+// service key and values. New connections hashed to a draining slot are
+// redirected to a single active backend, the draining slots are assigned to
+// the active backends in a round robin fashion. Until the drain period ends,
+// the active backends taking over a draining slot receive a larger share of
+// new connections.
+func lbSlots2ServiceKeynValue(svc types.LBSVC, slots []lbSlot) (lbmap.ServiceKey, []lbmap.ServiceValue, error) {
+	active := []int{}
+	svc.BES = make([]types.LBBackEnd, len(slots))
+	for i, slot := range slots {
+		svc.BES[i] = slot.LBBackEnd
+		if !slot.isDraining() {
+			// Slave 0 is reserved for the master
+			active = append(active, i+1)
+		}
+	}
+
+	fe, besValues, err := lbmap.LBSVC2ServiceKeynValue(svc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := 0
+	for i, slot := range slots {
+		if slot.isDraining() {
+			if len(active) == 0 {
+				return nil, nil, fmt.Errorf("no active backend to take over new connections from draining backend %s", slot.String())
+			}
+			besValues[i].SetDrainSlave(active[n%len(active)])
+			n++
+		}
+	}
+
+	return fe, besValues, nil
+}
+
+// restoreLBSlots returns the backend slots of a service restored from the BPF
+// services map. bes is indexed by slave - 1 and contains the active backends,
+// draining contains the draining backends by slave. count is the number of
+// backends of the master entry, entries beyond it are stale and ignored.
+// Empty slots are removed. As the remaining drain period is unknown, draining
+// backends drain for another drainTimeout. Draining backends are dropped if
+// draining is disabled or no active backend is left.
+func restoreLBSlots(bes []types.LBBackEnd, draining map[int]types.LBBackEnd, count int, drainTimeout time.Duration, now time.Time) []lbSlot {
+	if len(bes) > count {
+		bes = bes[:count]
+	}
+
+	active := 0
+	for _, be := range bes {
+		if be.IP != nil {
+			active++
+		}
+	}
+
+	slots := make([]lbSlot, 0, count)
+	for slave := 1; slave <= count; slave++ {
+		if be, ok := draining[slave]; ok {
+			if drainTimeout != 0 && active != 0 {
+				slots = append(slots, lbSlot{LBBackEnd: be, drainUntil: now.Add(drainTimeout)})
+			}
+		} else if slave <= len(bes) && bes[slave-1].IP != nil {
+			slots = append(slots, lbSlot{LBBackEnd: bes[slave-1]})
+		}
+	}
+
+	return slots
+}
+
+// activeLBBackends returns the backends of all active slots
+func activeLBBackends(slots []lbSlot) []types.LBBackEnd {
+	bes := []types.LBBackEnd{}
+	for _, slot := range slots {
+		if !slot.isDraining() {
+			bes = append(bes, slot.LBBackEnd)
+		}
+	}
+	return bes
+}
+
+// hasDrainingLBSlots returns true if any of the slots is draining
+func hasDrainingLBSlots(slots []lbSlot) bool {
+	for _, slot := range slots {
+		if slot.isDraining() {
+			return true
+		}
+	}
+	return false
+}
+
+// expireLBSlots removes the slots of backends with an expired drain period
+// from the service with the given ID.
+func (d *Daemon) expireLBSlots(id types.ServiceID) {
+	d.loadBalancer.BPFMapMU.Lock()
+	defer d.loadBalancer.BPFMapMU.Unlock()
+
+	svc, ok := d.loadBalancer.SVCMapID[id]
+	if !ok {
+		delete(d.lbSlots, id)
+		return
+	}
+
+	slots, _ := computeLBSlots(d.lbSlots[id], svc.BES, d.conf.LBDrainTimeout, time.Now())
+	fe, besValues, err := lbSlots2ServiceKeynValue(*svc, slots)
+	if err == nil {
+		err = d.addSVC2BPFMap(svc.FE, fe, besValues, false)
+	}
+	if err != nil {
+		log.Warningf("Unable to remove drained backends of service %s: %s", svc.FE.String(), err)
+		return
+	}
+
+	d.lbSlots[id] = slots
+}
@@ -0,0 +1,155 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net"
+	"time"
+
+	"github.com/cilium/cilium/common/types"
+
+	. "gopkg.in/check.v1"
+)
+
+type LBDrainSuite struct{}
+
+var _ = Suite(&LBDrainSuite{})
+
+func newTestBackend(ip string, port uint16) types.LBBackEnd {
+	return types.LBBackEnd{
+		L3n4Addr: types.L3n4Addr{
+			IP:     net.ParseIP(ip),
+			L4Addr: types.L4Addr{Protocol: types.TCP, Port: port},
+		},
+	}
+}
+
+func slotBackends(slots []lbSlot) []string {
+	res := []string{}
+	for _, s := range slots {
+		state := ""
+		if s.isDraining() {
+			state = " (draining)"
+		}
+		res = append(res, s.String()+state)
+	}
+	return res
+}
+
+func (s *LBDrainSuite) TestComputeLBSlots(c *C) {
+	now := time.Unix(1000, 0)
+	timeout := 10 * time.Second
+	be1 := newTestBackend("10.0.0.1", 80)
+	be2 := newTestBackend("10.0.0.2", 80)
+	be3 := newTestBackend("10.0.0.3", 80)
+
+	slots, draining := computeLBSlots(nil, []types.LBBackEnd{be1, be2}, timeout, now)
+	c.Assert(draining, Equals, false)
+	c.Assert(slotBackends(slots), DeepEquals, []string{"10.0.0.1:80/TCP", "10.0.0.2:80/TCP"})
+
+	// be1 is removed and drains in place, be3 is appended
+	slots, draining = computeLBSlots(slots, []types.LBBackEnd{be2, be3}, timeout, now)
+	c.Assert(draining, Equals, true)
+	c.Assert(slotBackends(slots), DeepEquals, []string{"10.0.0.1:80/TCP (draining)", "10.0.0.2:80/TCP", "10.0.0.3:80/TCP"})
+	c.Assert(slots[0].drainUntil, Equals, now.Add(timeout))
+
+	// Further updates do not extend the drain period
+	slots, draining = computeLBSlots(slots, []types.LBBackEnd{be2, be3}, timeout, now.Add(time.Second))
+	c.Assert(draining, Equals, false)
+	c.Assert(slots[0].drainUntil, Equals, now.Add(timeout))
+
+	// Re-adding a draining backend makes it active again
+	readded, _ := computeLBSlots(slots, []types.LBBackEnd{be1, be2, be3}, timeout, now)
+	c.Assert(slotBackends(readded), DeepEquals, []string{"10.0.0.1:80/TCP", "10.0.0.2:80/TCP", "10.0.0.3:80/TCP"})
+
+	// Expired backends are removed
+	slots, _ = computeLBSlots(slots, []types.LBBackEnd{be2, be3}, timeout, now.Add(timeout))
+	c.Assert(slotBackends(slots), DeepEquals, []string{"10.0.0.2:80/TCP", "10.0.0.3:80/TCP"})
+}
+
+func (s *LBDrainSuite) TestComputeLBSlotsNoDrain(c *C) {
+	now := time.Unix(1000, 0)
+	be1 := newTestBackend("10.0.0.1", 80)
+	be2 := newTestBackend("10.0.0.2", 80)
+
+	old, _ := computeLBSlots(nil, []types.LBBackEnd{be1, be2}, time.Second, now)
+
+	// Draining disabled
+	slots, draining := computeLBSlots(old, []types.LBBackEnd{be2}, 0, now)
+	c.Assert(draining, Equals, false)
+	c.Assert(slotBackends(slots), DeepEquals, []string{"10.0.0.2:80/TCP"})
+
+	// No backend left to take over new connections
+	slots, draining = computeLBSlots(old, []types.LBBackEnd{}, time.Second, now)
+	c.Assert(draining, Equals, false)
+	c.Assert(len(slots), Equals, 0)
+}
+
+func (s *LBDrainSuite) TestLBSlots2ServiceKeynValue(c *C) {
+	now := time.Unix(1000, 0)
+	be1 := newTestBackend("10.0.0.1", 80)
+	be2 := newTestBackend("10.0.0.2", 80)
+	be3 := newTestBackend("10.0.0.3", 80)
+
+	svc := types.LBSVC{FE: types.L3n4AddrID{L3n4Addr: newTestBackend("10.1.0.1", 80).L3n4Addr, ID: 1}}
+	slots, _ := computeLBSlots(nil, []types.LBBackEnd{be1, be2, be3}, time.Second, now)
+	slots, _ = computeLBSlots(slots, []types.LBBackEnd{be2}, time.Second, now)
+
+	_, values, err := lbSlots2ServiceKeynValue(svc, slots)
+	c.Assert(err, IsNil)
+	c.Assert(len(values), Equals, 3)
+	c.Assert(values[0].GetDrainSlave(), Equals, 2)
+	c.Assert(values[1].GetDrainSlave(), Equals, 0)
+	c.Assert(values[2].GetDrainSlave(), Equals, 2)
+}
+
+func (s *LBDrainSuite) TestRestoreLBSlots(c *C) {
+	now := time.Unix(1000, 0)
+	timeout := 10 * time.Second
+	be1 := newTestBackend("10.0.0.1", 80)
+	be2 := newTestBackend("10.0.0.2", 80)
+	be3 := newTestBackend("10.0.0.3", 80)
+
+	// Slave 1 is draining and left empty by AddFEnBE
+	bes := []types.LBBackEnd{{}, be2, be3}
+	draining := map[int]types.LBBackEnd{1: be1}
+
+	slots := restoreLBSlots(bes, draining, 3, timeout, now)
+	c.Assert(slotBackends(slots), DeepEquals, []string{"10.0.0.1:80/TCP (draining)", "10.0.0.2:80/TCP", "10.0.0.3:80/TCP"})
+	c.Assert(slots[0].drainUntil, Equals, now.Add(timeout))
+	c.Assert(activeLBBackends(slots), DeepEquals, []types.LBBackEnd{be2, be3})
+	c.Assert(hasDrainingLBSlots(slots), Equals, true)
+
+	// Draining slot after the active slots
+	slots = restoreLBSlots([]types.LBBackEnd{be2}, map[int]types.LBBackEnd{2: be1}, 2, timeout, now)
+	c.Assert(slotBackends(slots), DeepEquals, []string{"10.0.0.2:80/TCP", "10.0.0.1:80/TCP (draining)"})
+
+	// Entries beyond the count of the master are stale, neither the
+	// expired draining backend nor the copy of an active backend return
+	slots = restoreLBSlots([]types.LBBackEnd{be2, {}, be2}, map[int]types.LBBackEnd{2: be1}, 1, timeout, now)
+	c.Assert(slotBackends(slots), DeepEquals, []string{"10.0.0.2:80/TCP"})
+	c.Assert(hasDrainingLBSlots(slots), Equals, false)
+
+	// Draining disabled, the empty slot is compacted
+	slots = restoreLBSlots(bes, draining, 3, 0, now)
+	c.Assert(slotBackends(slots), DeepEquals, []string{"10.0.0.2:80/TCP", "10.0.0.3:80/TCP"})
+	c.Assert(hasDrainingLBSlots(slots), Equals, false)
+
+	svc := types.LBSVC{FE: types.L3n4AddrID{L3n4Addr: newTestBackend("10.1.0.1", 80).L3n4Addr, ID: 1}}
+	svc.BES = activeLBBackends(slots)
+	_, values, err := lbSlots2ServiceKeynValue(svc, slots)
+	c.Assert(err, IsNil)
+	c.Assert(len(values), Equals, 2)
+}
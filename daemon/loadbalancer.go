@@ -16,6 +16,7 @@ package main
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cilium/cilium/api/v1/models"
 	. "github.com/cilium/cilium/api/v1/server/restapi/service"
@@ -87,6 +88,8 @@ func (d *Daemon) SVCAdd(feL3n4Addr types.L3n4AddrID, be []types.LBBackEnd, addRe
 // one set in fe, it returns an error without modifying the bpf LB map. If any backend
 // entry fails while updating the LB map, the frontend won't be inserted in the LB map
 // therefore there won't be any traffic going to the given backends.
+// If fe is ambiguous with the frontend of another service, see
+// L3n4Addr.IsAmbiguous(), it returns an error without modifying the bpf LB map.
 // All of the backends added will be DeepCopied to the internal load balancer map.
 func (d *Daemon) svcAdd(feL3n4Addr types.L3n4AddrID, bes []types.LBBackEnd, addRevNAT bool) (bool, error) {
 	// We will move the slice to the loadbalancer map which have a mutex. If we don't
@@ -102,19 +105,34 @@ func (d *Daemon) svcAdd(feL3n4Addr types.L3n4AddrID, bes []types.LBBackEnd, addR
 		Sha256: feL3n4Addr.L3n4Addr.SHA256Sum(),
 	}
 
-	fe, besValues, err := lbmap.LBSVC2ServiceKeynValue(svc)
+	d.loadBalancer.BPFMapMU.Lock()
+	defer d.loadBalancer.BPFMapMU.Unlock()
+
+	if other := d.loadBalancer.SVCMap.FindAmbiguous(feL3n4Addr); other != nil {
+		return false, fmt.Errorf("service %s is ambiguous with service %s (ID %d)",
+			feL3n4Addr.String(), other.FE.String(), other.FE.ID)
+	}
+
+	// Backends removed from the service are drained, see computeLBSlots()
+	drainTimeout := d.conf.LBDrainTimeout
+	slots, draining := computeLBSlots(d.lbSlots[feL3n4Addr.ID], beCpy, drainTimeout, time.Now())
+
+	fe, besValues, err := lbSlots2ServiceKeynValue(svc, slots)
 	if err != nil {
 		return false, err
 	}
 
-	d.loadBalancer.BPFMapMU.Lock()
-	defer d.loadBalancer.BPFMapMU.Unlock()
-
 	err = d.addSVC2BPFMap(feL3n4Addr, fe, besValues, addRevNAT)
 	if err != nil {
 		return false, err
 	}
 
+	d.lbSlots[feL3n4Addr.ID] = slots
+	if draining {
+		id := feL3n4Addr.ID
+		time.AfterFunc(drainTimeout, func() { d.expireLBSlots(id) })
+	}
+
 	return d.loadBalancer.AddService(svc), nil
 }
 
@@ -209,20 +227,14 @@ func (d *Daemon) svcDeleteByFrontend(frontend *types.L3n4Addr) error {
 }
 
 func (d *Daemon) svcDelete(svc *types.LBSVC) error {
-	var svcKey lbmap.ServiceKey
-	if !svc.FE.IsIPv6() {
-		svcKey = lbmap.NewService4Key(svc.FE.IP, svc.FE.Port, 0)
-	} else {
-		svcKey = lbmap.NewService6Key(svc.FE.IP, svc.FE.Port, 0)
-	}
-
-	svcKey.SetBackend(0)
+	svcKey := lbmap.L3n4Addr2ServiceKey(svc.FE.L3n4Addr)
 
 	if err := lbmap.DeleteService(svcKey); err != nil {
 		return err
 	}
 
 	d.loadBalancer.DeleteService(svc)
+	delete(d.lbSlots, svc.FE.ID)
 
 	return nil
 }
@@ -397,20 +409,38 @@ func (d *Daemon) SyncLBMap() error {
 	newRevNATMap := types.RevNATMap{}
 	failedSyncSVC := []types.LBSVC{}
 	failedSyncRevNAT := map[types.ServiceID]types.L3n4Addr{}
+	drainingBES := map[string]map[int]types.LBBackEnd{}
+	svcCounts := map[string]int{}
 
 	parseSVCEntries := func(key bpf.MapKey, value bpf.MapValue) {
 		svcKey := key.(lbmap.ServiceKey)
+		svcValue := value.(lbmap.ServiceValue)
 		//It's the frontend service so we don't add this one
 		if svcKey.GetBackend() == 0 {
+			fe, err := lbmap.ServiceKey2L3n4Addr(svcKey)
+			if err != nil {
+				log.Errorf("%s", err)
+				return
+			}
+			svcCounts[fe.SHA256Sum()] = svcValue.GetCount()
 			return
 		}
-		svcValue := value.(lbmap.ServiceValue)
 		fe, be, err := lbmap.ServiceKeynValue2FEnBE(svcKey, svcValue)
 		if err != nil {
 			log.Errorf("%s", err)
 			return
 		}
 
+		// Draining backends are not part of the service, see restoreLBSlots()
+		if svcValue.GetDrainSlave() != 0 {
+			sha := fe.SHA256Sum()
+			if _, ok := drainingBES[sha]; !ok {
+				drainingBES[sha] = map[int]types.LBBackEnd{}
+			}
+			drainingBES[sha][svcKey.GetBackend()] = *be
+			return
+		}
+
 		newSVCMap.AddFEnBE(fe, be, svcKey.GetBackend())
 	}
 
@@ -425,7 +455,7 @@ func (d *Daemon) SyncLBMap() error {
 		newRevNATMap[fe.ID] = fe.L3n4Addr
 	}
 
-	addSVC2BPFMap := func(oldID types.ServiceID, svc types.LBSVC) error {
+	addSVC2BPFMap := func(oldID types.ServiceID, svc types.LBSVC, slots []lbSlot) error {
 		// check if the reverser nat is present on the bpf map and update the
 		// reverse nat key and delete the old one.
 		revNAT, ok := newRevNATMap[oldID]
@@ -447,7 +477,7 @@ func (d *Daemon) SyncLBMap() error {
 			newRevNATMap[svc.FE.ID] = revNAT
 		}
 
-		fe, besValues, err := lbmap.LBSVC2ServiceKeynValue(svc)
+		fe, besValues, err := lbSlots2ServiceKeynValue(svc, slots)
 		if err != nil {
 			return fmt.Errorf("Unable to create a BPF key and values for service FE: %s and backends: %+v. Error: %s."+
 				" This entry will be removed from the bpf's LB map.", svc.FE.String(), svc.BES, err)
@@ -473,6 +503,28 @@ func (d *Daemon) SyncLBMap() error {
 	lbmap.Service6Map.Dump(lbmap.Service6DumpParser, parseSVCEntries)
 	lbmap.RevNat6Map.Dump(lbmap.RevNat6DumpParser, parseRevNATEntries)
 
+	// Restore the backend slots, the backends of draining slots and slots
+	// left empty by them are removed from the services. Backend entries
+	// beyond the count of the master entry are stale.
+	now := time.Now()
+	svcSlots := map[string][]lbSlot{}
+	for k, svc := range newSVCMap {
+		count, ok := svcCounts[k]
+		if !ok {
+			// Without a master entry all backend entries are kept
+			count = len(svc.BES)
+			for slave := range drainingBES[k] {
+				if slave > count {
+					count = slave
+				}
+			}
+		}
+		slots := restoreLBSlots(svc.BES, drainingBES[k], count, d.conf.LBDrainTimeout, now)
+		svc.BES = activeLBBackends(slots)
+		newSVCMap[k] = svc
+		svcSlots[k] = slots
+	}
+
 	// Let's check if the services read from the lbmap have the same ID set in the
 	// KVStore.
 	for k, svc := range newSVCMap {
@@ -489,7 +541,7 @@ func (d *Daemon) SyncLBMap() error {
 			log.Infof("Service ID was out of sync, got new ID %d -> %d", svc.FE.ID, kvL3n4AddrID.ID)
 			oldID := svc.FE.ID
 			svc.FE.ID = kvL3n4AddrID.ID
-			if err := addSVC2BPFMap(oldID, svc); err != nil {
+			if err := addSVC2BPFMap(oldID, svc, svcSlots[k]); err != nil {
 				log.Errorf("%s", err)
 
 				failedSyncSVC = append(failedSyncSVC, svc)
@@ -505,22 +557,25 @@ func (d *Daemon) SyncLBMap() error {
 				continue
 			}
 			newSVCMap[k] = svc
-			newSVCMapID[svc.FE.ID] = &svc
+		} else if len(drainingBES[k]) != 0 {
+			// Slots may have been removed, rewrite the service
+			fe, besValues, err := lbSlots2ServiceKeynValue(svc, svcSlots[k])
+			if err == nil {
+				err = d.addSVC2BPFMap(svc.FE, fe, besValues, false)
+			}
+			if err != nil {
+				log.Errorf("Unable to restore draining backends of service %s: %s."+
+					" This entry will be removed from the bpf's LB map.", svc.FE.String(), err)
+				failedSyncSVC = append(failedSyncSVC, svc)
+				delete(newSVCMap, k)
+			}
 		}
 	}
 
 	// Clean services and rev nats that failed while restoring
 	for _, svc := range failedSyncSVC {
 		log.Debugf("Removing service: %s", svc.FE)
-		feL3n4 := svc.FE
-		var svcKey lbmap.ServiceKey
-		if !feL3n4.IsIPv6() {
-			svcKey = lbmap.NewService4Key(feL3n4.IP, feL3n4.Port, 0)
-		} else {
-			svcKey = lbmap.NewService6Key(feL3n4.IP, feL3n4.Port, 0)
-		}
-
-		svcKey.SetBackend(0)
+		svcKey := lbmap.L3n4Addr2ServiceKey(svc.FE.L3n4Addr)
 
 		if err := lbmap.DeleteService(svcKey); err != nil {
 			log.Warningf("Unable to clean service %s from BPF map: %s", svc.FE, err)
@@ -541,6 +596,18 @@ func (d *Daemon) SyncLBMap() error {
 		}
 	}
 
+	d.lbSlots = map[types.ServiceID][]lbSlot{}
+	for k := range newSVCMap {
+		svc := newSVCMap[k]
+		newSVCMapID[svc.FE.ID] = &svc
+
+		d.lbSlots[svc.FE.ID] = svcSlots[k]
+		if hasDrainingLBSlots(svcSlots[k]) {
+			id := svc.FE.ID
+			time.AfterFunc(d.conf.LBDrainTimeout, func() { d.expireLBSlots(id) })
+		}
+	}
+
 	d.loadBalancer.SVCMap = newSVCMap
 	d.loadBalancer.SVCMapID = newSVCMapID
 	d.loadBalancer.RevNATMap = newRevNATMap
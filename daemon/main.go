@@ -35,9 +35,12 @@ import (
 	"github.com/cilium/cilium/daemon/options"
 	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/endpoint"
+	"github.com/cilium/cilium/pkg/flagalias"
+	"github.com/cilium/cilium/pkg/hooks"
 	"github.com/cilium/cilium/pkg/k8s"
 	"github.com/cilium/cilium/pkg/kvstore"
 	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/mirror"
 	"github.com/cilium/cilium/pkg/version"
 
 	log "github.com/Sirupsen/logrus"
@@ -77,6 +80,7 @@ var (
 	logstashAddr       string
 	logstashProbeTimer uint32
 	loggers            []string
+	mirrorLabels       []string
 	nat46prefix        string
 	socketPath         string
 	v4Prefix           string
@@ -88,12 +92,18 @@ var kvStoreOpts = make(map[string]string)
 
 var cfgFile string
 
+// flagAliases is the list of renamed flags. The deprecated names continue to
+// be accepted with a warning until the release given in RemovedIn.
+var flagAliases = []flagalias.Alias{
+	{Name: "lb", Target: "lb-interface", RemovedIn: "0.10"},
+}
+
 // RootCmd represents the base command when called without any subcommands
 var RootCmd = &cobra.Command{
 	Use:   "daemon",
 	Short: "Run cilium daemon",
 	Run: func(cmd *cobra.Command, args []string) {
-		initEnv()
+		initEnv(cmd)
 		runDaemon()
 	},
 }
@@ -230,6 +240,16 @@ func init() {
 	flags.StringVarP(&config.DockerEndpoint, "docker", "e", "unix:///var/run/docker.sock",
 		"Register a listener for docker events on the given endpoint")
 	flags.BoolVar(&enableTracing, "enable-tracing", false, "Enable tracing while determining policy")
+	flags.StringVar(&config.Hooks.URL, "endpoint-hook-url", "",
+		"URL to post endpoint lifecycle events to")
+	flags.StringVar(&config.Hooks.Exec, "endpoint-hook-exec", "",
+		"Program to execute with the event on stdin for every endpoint lifecycle event")
+	flags.DurationVar(&config.Hooks.Timeout, "endpoint-hook-timeout", hooks.DefaultTimeout,
+		"Timeout of a single delivery of an endpoint lifecycle event")
+	flags.IntVar(&config.Hooks.Retries, "endpoint-hook-retries", hooks.DefaultRetries,
+		"Number of retries of a failed delivery of an endpoint lifecycle event")
+	flags.DurationVar(&config.Hooks.RetryInterval, "endpoint-hook-retry-interval", hooks.DefaultRetryInterval,
+		"Interval before the first retry of a failed delivery, doubles with every retry")
 	flags.StringVar(&nat46prefix, "nat46-range", addressing.DefaultNAT46Prefix,
 		"IPv6 prefix to map IPv4 addresses to")
 	flags.StringVar(&config.K8sEndpoint, "k8s-api-server", "", "Kubernetes api address server")
@@ -248,6 +268,14 @@ func init() {
 	flags.BoolVar(&enableLogstash, "logstash", false, "Enable logstash integration")
 	flags.StringVar(&logstashAddr, "logstash-agent", "127.0.0.1:8080", "Logstash agent address")
 	flags.Uint32Var(&logstashProbeTimer, "logstash-probe-timer", 10, "Logstash probe timer (seconds)")
+	flags.StringVar(&config.Mirror.Collector, "mirror-collector", "",
+		"Interface name or endpoint ID to mirror the traffic of selected endpoints to")
+	flags.StringSliceVar(&mirrorLabels, "mirror-labels", []string{},
+		"List of labels selecting the endpoints to mirror traffic of (Default: all endpoints)")
+	flags.Uint32Var(&config.Mirror.SampleRate, "mirror-sample-rate", mirror.DefaultSampleRate,
+		"Mirror one out of N packets")
+	flags.Uint32Var(&config.Mirror.RateLimit, "mirror-rate-limit", mirror.DefaultRateLimit,
+		"Maximum number of mirrored packets per second and CPU (0 = unlimited)")
 	flags.StringVarP(&v6Address, "node-address", "n", "", "IPv6 address of node, must be in correct format")
 	flags.BoolVar(&config.RestoreState, "restore", false,
 		"Restores state, if possible, from previous daemon")
@@ -256,8 +284,16 @@ func init() {
 	flags.StringVar(&config.RunDir, "state-dir", defaults.RuntimePath, "Path to directory to store runtime state")
 	flags.StringVar(&config.LibDir, "lib-dir", defaults.LibraryPath, "Path to store runtime build environment")
 	flags.StringVar(&socketPath, "socket-path", defaults.SockPath, "Sets the socket path to listen for connections")
-	flags.StringVar(&config.LBInterface, "lb", "",
+	flags.StringVar(&config.ReadOnlyAPI, "readonly-api", "",
+		"Serve the read-only API at unix:///path or tcp://host:port (TCP requires TLS)")
+	flags.StringVar(&config.ReadOnlyAPITLSCert, "readonly-api-tls-cert", "", "TLS certificate of the read-only API")
+	flags.StringVar(&config.ReadOnlyAPITLSKey, "readonly-api-tls-key", "", "TLS private key of the read-only API")
+	flags.StringVar(&config.ReadOnlyAPITLSCA, "readonly-api-tls-ca", "",
+		"CA to verify client certificates of the read-only API against")
+	flags.StringVar(&config.LBInterface, "lb-interface", "",
 		"Enables load balancer mode where load balancer bpf program is attached to the given interface")
+	flags.DurationVar(&config.LBDrainTimeout, "lb-drain-timeout", 0,
+		"Period during which backends removed from a service continue to serve established connections (0 = disabled)")
 	flags.BoolVar(&config.IPv4Disabled, "disable-ipv4", false, "Disable IPv4 mode")
 	flags.StringVar(&v4Prefix, "ipv4-range", "", "IPv4 prefix")
 	flags.StringVarP(&config.Tunnel, "tunnel", "t", "vxlan", "Tunnel mode vxlan or geneve, vxlan is the default")
@@ -266,6 +302,9 @@ func init() {
 	flags.Bool("version", false, "Print version information")
 	flags.StringSliceVar(&loggers, "log-driver", []string{}, "logging endpoints to use")
 	flags.Var(common.NewNamedMapOptions("log-opts", &logOpts, nil), "log-opt", "log driver options for cilium")
+	if err := flagalias.Register(flags, flagAliases); err != nil {
+		log.Fatalf("Unable to register deprecated flags: %s", err)
+	}
 	viper.BindPFlags(flags)
 }
 
@@ -419,9 +458,12 @@ func SetupKvStore(kvStore string, kvStoreOpts map[string]string) error {
 	return nil
 }
 
-func initEnv() {
+func initEnv(cmd *cobra.Command) {
 	common.SetupLogging(loggers, logOpts, "cilium-agent", viper.GetBool("debug"))
 
+	// The use of deprecated flags has already been warned about while parsing
+	config.DeprecatedFlags = flagalias.Used(cmd.Flags(), flagAliases)
+
 	socketDir := path.Dir(socketPath)
 	if err := os.MkdirAll(socketDir, defaults.RuntimePathRights); err != nil {
 		log.Fatalf("Cannot mkdir directory %q for cilium socket: %s", socketDir, err)
@@ -491,6 +533,11 @@ func initEnv() {
 
 	config.ValidLabelPrefixesMU.Unlock()
 
+	config.Mirror.Selector = labels.ParseLabelArrayFromArray(mirrorLabels)
+	if err := config.Mirror.Validate(); err != nil {
+		log.Fatalf("Invalid mirror configuration: %s", err)
+	}
+
 	_, r, err := net.ParseCIDR(nat46prefix)
 	if err != nil {
 		log.Fatalf("Invalid NAT46 prefix %s: %s", nat46prefix, err)
@@ -539,6 +586,10 @@ func runDaemon() {
 
 	d.EnableKVStoreWatcher(30 * time.Second)
 
+	if err := d.EnableNodeRegistration(); err != nil {
+		log.Warningf("Unable to register node in kvstore: %s", err)
+	}
+
 	if err := d.EnableK8sWatcher(5 * time.Minute); err != nil {
 		log.Warningf("Error while enabling k8s watcher %s", err)
 	}
@@ -561,6 +612,9 @@ func runDaemon() {
 	api.DaemonGetConfigHandler = NewGetConfigHandler(d)
 	api.DaemonPatchConfigHandler = NewPatchConfigHandler(d)
 
+	// /node/
+	api.DaemonGetNodeHandler = NewGetNodeHandler(d)
+
 	// /endpoint/
 	api.EndpointGetEndpointHandler = NewGetEndpointHandler(d)
 
@@ -610,6 +664,10 @@ func runDaemon() {
 
 	server.ConfigureAPI()
 
+	if err := d.serveReadOnlyAPI(server.GetHandler()); err != nil {
+		log.Fatal(err)
+	}
+
 	if err := server.Serve(); err != nil {
 		log.Fatal(err)
 	}
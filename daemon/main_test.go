@@ -0,0 +1,48 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type MainSuite struct{}
+
+var _ = Suite(&MainSuite{})
+
+// TestFlagAliasesExpired ensures that deprecated flag names are removed in
+// the release announced to users.
+func (s *MainSuite) TestFlagAliasesExpired(c *C) {
+	version, err := ioutil.ReadFile("../VERSION")
+	c.Assert(err, IsNil)
+
+	for _, a := range flagAliases {
+		expired, err := a.Expired(strings.TrimSpace(string(version)))
+		c.Assert(err, IsNil)
+		c.Assert(expired, Equals, false, Commentf("flag --%s was to be removed in %s", a.Name, a.RemovedIn))
+	}
+}
+
+func (s *MainSuite) TestFlagAliasesRegistered(c *C) {
+	for _, a := range flagAliases {
+		alias := RootCmd.Flags().Lookup(a.Name)
+		c.Assert(alias, Not(IsNil), Commentf("flag --%s not registered", a.Name))
+		c.Assert(alias.Value, Equals, RootCmd.Flags().Lookup(a.Target).Value)
+		c.Assert(alias.Deprecated, Not(Equals), "")
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/mirror"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+)
+
+// initMirror resolves the interface index of the mirror collector if the
+// collector is a network interface. Collector endpoints are resolved as soon
+// as the endpoint is inserted.
+func (d *Daemon) initMirror() error {
+	m := d.conf.Mirror
+	if !m.Enabled() {
+		return nil
+	}
+
+	if id, ok := m.CollectorEndpointID(); ok {
+		log.Infof("Mirroring traffic of endpoints matching %s to endpoint %d", m.Selector, id)
+		return nil
+	}
+
+	link, err := netlink.LinkByName(m.Collector)
+	if err != nil {
+		return fmt.Errorf("unable to find mirror collector interface %s: %s", m.Collector, err)
+	}
+
+	d.mirrorMU.Lock()
+	d.mirrorIfIndex = link.Attrs().Index
+	d.mirrorMU.Unlock()
+
+	log.Infof("Mirroring traffic of endpoints matching %s to interface %s", m.Selector, m.Collector)
+
+	return nil
+}
+
+// updateMirrorCollector updates the interface index of the mirror collector
+// if the endpoint with the given id is the collector and regenerates all
+// other endpoints if the index has changed. An ifindex of 0 indicates the
+// removal of the collector endpoint. To be used with endpointsMU locked.
+func (d *Daemon) updateMirrorCollector(id uint16, ifindex int) {
+	if collectorID, ok := d.conf.Mirror.CollectorEndpointID(); !ok || collectorID != id {
+		return
+	}
+
+	d.mirrorMU.Lock()
+	changed := d.mirrorIfIndex != ifindex
+	d.mirrorIfIndex = ifindex
+	d.mirrorMU.Unlock()
+
+	if !changed {
+		return
+	}
+
+	log.Infof("Mirror collector endpoint %d now at ifindex %d, regenerating endpoints", id, ifindex)
+
+	for k, ep := range d.endpoints {
+		if k != id {
+			ep.Regenerate(d)
+		}
+	}
+}
+
+// GetMirrorTarget returns the mirror target for the endpoint with the given
+// ID and labels or nil if the traffic of the endpoint is not to be mirrored.
+func (d *Daemon) GetMirrorTarget(id uint16, lbls labels.LabelArray) *mirror.Target {
+	m := d.conf.Mirror
+	if !m.Selects(lbls) {
+		return nil
+	}
+
+	// Never mirror the traffic of the collector itself
+	if collectorID, ok := m.CollectorEndpointID(); ok && collectorID == id {
+		return nil
+	}
+
+	d.mirrorMU.RLock()
+	ifindex := d.mirrorIfIndex
+	d.mirrorMU.RUnlock()
+
+	if ifindex == 0 {
+		return nil
+	}
+
+	return m.NewTarget(ifindex)
+}
@@ -0,0 +1,162 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"net"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/models"
+	. "github.com/cilium/cilium/api/v1/server/restapi/daemon"
+	"github.com/cilium/cilium/common"
+	"github.com/cilium/cilium/pkg/apierror"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// advertisedAPIAddress returns the address at which other nodes can reach
+// the read-only API or an empty string if it is not served over TCP. An
+// unspecified listen address is replaced with the node name.
+func advertisedAPIAddress(readOnlyAPI, nodeName string) string {
+	network, addr, err := parseAPIAddress(readOnlyAPI)
+	if err != nil || network != "tcp" {
+		return ""
+	}
+
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return ""
+	}
+
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = nodeName
+	}
+
+	return "tcp://" + net.JoinHostPort(host, port)
+}
+
+const (
+	// nodeRegistrationInterval is the interval at which the registration
+	// of the node in the kvstore is refreshed
+	nodeRegistrationInterval = time.Minute
+
+	// nodeRegistrationTTL is the period after which a registration which
+	// has not been refreshed is considered stale, e.g. because the node
+	// has been removed or renamed
+	nodeRegistrationTTL = 5 * nodeRegistrationInterval
+)
+
+// nodeRegistration is the registration of a node as stored in the kvstore
+type nodeRegistration struct {
+	models.Node
+
+	// Updated is the time of the last refresh of the registration
+	Updated time.Time `json:"updated"`
+}
+
+// isStale returns true if the registration has not been refreshed within
+// nodeRegistrationTTL
+func (r *nodeRegistration) isStale(now time.Time) bool {
+	return now.Sub(r.Updated) > nodeRegistrationTTL
+}
+
+// registerNode registers the node in the kvstore so that clients can reach
+// the agents of all nodes.
+func (d *Daemon) registerNode(name string) error {
+	reg := &nodeRegistration{
+		Node: models.Node{
+			Name:       name,
+			APIAddress: advertisedAPIAddress(d.conf.ReadOnlyAPI, name),
+		},
+		Updated: time.Now(),
+	}
+
+	return d.kvClient.SetValue(path.Join(common.NodesKeyPath, name), reg)
+}
+
+// EnableNodeRegistration registers the node in the kvstore and refreshes the
+// registration every nodeRegistrationInterval.
+func (d *Daemon) EnableNodeRegistration() error {
+	name, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Registering node %s with API address %q", name,
+		advertisedAPIAddress(d.conf.ReadOnlyAPI, name))
+
+	err = d.registerNode(name)
+
+	go func() {
+		for range time.Tick(nodeRegistrationInterval) {
+			if err := d.registerNode(name); err != nil {
+				log.Warningf("Unable to refresh node registration in kvstore: %s", err)
+			}
+		}
+	}()
+
+	return err
+}
+
+// nodesFromRegistrations returns the nodes of all registrations which are
+// not stale, sorted by key.
+func nodesFromRegistrations(values map[string]json.RawMessage, now time.Time) []*models.Node {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	nodes := []*models.Node{}
+	for _, k := range keys {
+		reg := &nodeRegistration{}
+		if err := json.Unmarshal(values[k], reg); err != nil {
+			log.Warningf("Ignoring invalid node registration %s: %s", k, err)
+			continue
+		}
+		if reg.isStale(now) {
+			log.Debugf("Ignoring stale node registration %s, last updated %s", k, reg.Updated)
+			continue
+		}
+		node := reg.Node
+		nodes = append(nodes, &node)
+	}
+
+	return nodes
+}
+
+type getNode struct {
+	d *Daemon
+}
+
+func NewGetNodeHandler(d *Daemon) GetNodeHandler {
+	return &getNode{d: d}
+}
+
+func (h *getNode) Handle(params GetNodeParams) middleware.Responder {
+	log.Debugf("GET /node request: %+v", params)
+
+	values, err := h.d.kvClient.ListPrefix(common.NodesKeyPath + "/")
+	if err != nil {
+		return apierror.Error(500, err)
+	}
+
+	return NewGetNodeOK().WithPayload(nodesFromRegistrations(values, time.Now()))
+}
@@ -0,0 +1,167 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// readOnlyAPIPaths is the list of API paths served by the read-only API.
+// Only GET requests are accepted for these paths. The agent API has no
+// metrics or flows endpoints which could be exposed here.
+var readOnlyAPIPaths = map[string]bool{
+	"/v1beta/healthz":  true,
+	"/v1beta/endpoint": true,
+	"/v1beta/node":     true,
+}
+
+// readOnlyHandler returns a handler which passes read requests for the
+// paths in readOnlyAPIPaths to h and rejects all other requests.
+func readOnlyHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed on read-only API", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if r.URL.RawPath != "" || !readOnlyAPIPaths[r.URL.Path] {
+			http.Error(w, "Path not available on read-only API", http.StatusForbidden)
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+// parseAPIAddress splits an address of the form unix:///path/to/socket or
+// tcp://host:port into network and address.
+func parseAPIAddress(addr string) (string, string, error) {
+	parts := strings.SplitN(addr, "://", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid address %q, must be unix:///path or tcp://host:port", addr)
+	}
+
+	switch parts[0] {
+	case "unix":
+		return "unix", parts[1], nil
+	case "tcp":
+		if _, _, err := net.SplitHostPort(parts[1]); err != nil {
+			return "", "", fmt.Errorf("invalid address %q: %s", addr, err)
+		}
+		return "tcp", parts[1], nil
+	default:
+		return "", "", fmt.Errorf("unsupported scheme %q in address %q", parts[0], addr)
+	}
+}
+
+// readOnlyAPITLSConfig returns the TLS configuration of the read-only API.
+// Clients must present a certificate signed by the CA if a CA is configured.
+func (c *Config) readOnlyAPITLSConfig() (*tls.Config, error) {
+	if c.ReadOnlyAPITLSCert == "" || c.ReadOnlyAPITLSKey == "" {
+		return nil, fmt.Errorf("TCP listener requires a TLS certificate and key")
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.ReadOnlyAPITLSCert, c.ReadOnlyAPITLSKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load TLS certificate: %s", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if c.ReadOnlyAPITLSCA != "" {
+		ca, err := ioutil.ReadFile(c.ReadOnlyAPITLSCA)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read TLS CA: %s", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificate found in TLS CA %s", c.ReadOnlyAPITLSCA)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
+
+// listenReadOnlyAPI creates the listener of the read-only API. Unix sockets
+// are accessible by all users as no request can modify the agent state.
+func (c *Config) listenReadOnlyAPI() (net.Listener, error) {
+	network, addr, err := parseAPIAddress(c.ReadOnlyAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	if network == "unix" {
+		if err := os.Remove(addr); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("unable to remove existing socket %q: %s", addr, err)
+		}
+
+		l, err := net.Listen(network, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := os.Chmod(addr, 0666); err != nil {
+			l.Close()
+			return nil, fmt.Errorf("unable to set permissions of %q: %s", addr, err)
+		}
+
+		return l, nil
+	}
+
+	tlsConfig, err := c.readOnlyAPITLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return tls.Listen(network, addr, tlsConfig)
+}
+
+// serveReadOnlyAPI serves the read-only subset of the API provided by h in
+// the background if a read-only API address has been configured.
+func (d *Daemon) serveReadOnlyAPI(h http.Handler) error {
+	if d.conf.ReadOnlyAPI == "" {
+		return nil
+	}
+
+	l, err := d.conf.listenReadOnlyAPI()
+	if err != nil {
+		return fmt.Errorf("unable to listen on read-only API address %s: %s", d.conf.ReadOnlyAPI, err)
+	}
+
+	log.Infof("Serving read-only API at %s", d.conf.ReadOnlyAPI)
+
+	go func() {
+		srv := &http.Server{Handler: readOnlyHandler(h)}
+		if err := srv.Serve(l); err != nil {
+			log.Fatalf("Error while serving read-only API: %s", err)
+		}
+	}()
+
+	return nil
+}
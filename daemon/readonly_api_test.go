@@ -0,0 +1,176 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/models"
+
+	. "gopkg.in/check.v1"
+)
+
+type ReadOnlyAPISuite struct{}
+
+var _ = Suite(&ReadOnlyAPISuite{})
+
+func (s *ReadOnlyAPISuite) TestReadOnlyHandler(c *C) {
+	served := 0
+	h := readOnlyHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+	}))
+
+	for _, t := range []struct {
+		method string
+		url    string
+		code   int
+	}{
+		{"GET", "/v1beta/healthz", http.StatusOK},
+		{"GET", "/v1beta/endpoint?labels=foo", http.StatusOK},
+		{"PUT", "/v1beta/endpoint", http.StatusMethodNotAllowed},
+		{"DELETE", "/v1beta/policy", http.StatusMethodNotAllowed},
+		{"GET", "/v1beta/policy", http.StatusForbidden},
+		{"GET", "/v1beta/endpoint/1", http.StatusForbidden},
+		{"GET", "/v1beta/endpoint/../policy", http.StatusForbidden},
+		{"GET", "/v1beta/%65ndpoint", http.StatusForbidden},
+	} {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(t.method, t.url, nil))
+		c.Assert(rec.Code, Equals, t.code, Commentf("%s %s", t.method, t.url))
+	}
+
+	c.Assert(served, Equals, 2)
+}
+
+func (s *ReadOnlyAPISuite) TestParseAPIAddress(c *C) {
+	network, addr, err := parseAPIAddress("unix:///var/run/cilium/readonly.sock")
+	c.Assert(err, IsNil)
+	c.Assert(network, Equals, "unix")
+	c.Assert(addr, Equals, "/var/run/cilium/readonly.sock")
+
+	network, addr, err = parseAPIAddress("tcp://0.0.0.0:9234")
+	c.Assert(err, IsNil)
+	c.Assert(network, Equals, "tcp")
+	c.Assert(addr, Equals, "0.0.0.0:9234")
+
+	for _, invalid := range []string{"", "/var/run/cilium.sock", "tcp://", "tcp://node2", "http://node2:9234"} {
+		_, _, err = parseAPIAddress(invalid)
+		c.Assert(err, Not(IsNil), Commentf("address %q", invalid))
+	}
+}
+
+// writeTestKeyPair writes a self-signed certificate and its key to dir
+func writeTestKeyPair(c *C, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c.Assert(err, IsNil)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "cilium-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	c.Assert(err, IsNil)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	c.Assert(err, IsNil)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	err = ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	c.Assert(err, IsNil)
+	err = ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)
+	c.Assert(err, IsNil)
+
+	return certFile, keyFile
+}
+
+func (s *ReadOnlyAPISuite) TestReadOnlyAPITLSConfig(c *C) {
+	conf := NewConfig()
+	conf.ReadOnlyAPI = "tcp://127.0.0.1:9234"
+	_, err := conf.listenReadOnlyAPI()
+	c.Assert(err, Not(IsNil))
+
+	dir, err := ioutil.TempDir("", "readonly-api")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+
+	conf.ReadOnlyAPITLSCert, conf.ReadOnlyAPITLSKey = writeTestKeyPair(c, dir)
+	tlsConfig, err := conf.readOnlyAPITLSConfig()
+	c.Assert(err, IsNil)
+	c.Assert(len(tlsConfig.Certificates), Equals, 1)
+	c.Assert(tlsConfig.ClientAuth, Equals, tls.NoClientCert)
+
+	conf.ReadOnlyAPITLSCA = conf.ReadOnlyAPITLSCert
+	tlsConfig, err = conf.readOnlyAPITLSConfig()
+	c.Assert(err, IsNil)
+	c.Assert(tlsConfig.ClientAuth, Equals, tls.RequireAndVerifyClientCert)
+	c.Assert(tlsConfig.ClientCAs, Not(IsNil))
+
+	// The CA file must contain a certificate
+	conf.ReadOnlyAPITLSCA = conf.ReadOnlyAPITLSKey
+	_, err = conf.readOnlyAPITLSConfig()
+	c.Assert(err, Not(IsNil))
+}
+
+func (s *ReadOnlyAPISuite) TestAdvertisedAPIAddress(c *C) {
+	c.Assert(advertisedAPIAddress("", "node1"), Equals, "")
+	c.Assert(advertisedAPIAddress("unix:///var/run/cilium/readonly.sock", "node1"), Equals, "")
+	c.Assert(advertisedAPIAddress("tcp://0.0.0.0:9234", "node1"), Equals, "tcp://node1:9234")
+	c.Assert(advertisedAPIAddress("tcp://[::]:9234", "node1"), Equals, "tcp://node1:9234")
+	c.Assert(advertisedAPIAddress("tcp://:9234", "node1"), Equals, "tcp://node1:9234")
+	c.Assert(advertisedAPIAddress("tcp://192.168.1.10:9234", "node1"), Equals, "tcp://192.168.1.10:9234")
+	c.Assert(advertisedAPIAddress("tcp://[f00d::1]:9234", "node1"), Equals, "tcp://[f00d::1]:9234")
+}
+
+func (s *ReadOnlyAPISuite) TestNodesFromRegistrations(c *C) {
+	now := time.Unix(10000, 0)
+	values := map[string]json.RawMessage{}
+	for name, updated := range map[string]time.Time{
+		"node2": now.Add(-time.Minute),
+		"node1": now,
+		"old":   now.Add(-nodeRegistrationTTL - time.Second),
+	} {
+		b, err := json.Marshal(&nodeRegistration{
+			Node:    models.Node{Name: name, APIAddress: "tcp://" + name + ":9234"},
+			Updated: updated,
+		})
+		c.Assert(err, IsNil)
+		values["cilium-net/operational/Nodes/"+name] = b
+	}
+	values["cilium-net/operational/Nodes/invalid"] = json.RawMessage("{")
+
+	nodes := nodesFromRegistrations(values, now)
+	c.Assert(nodes, DeepEquals, []*models.Node{
+		{Name: "node1", APIAddress: "tcp://node1:9234"},
+		{Name: "node2", APIAddress: "tcp://node2:9234"},
+	})
+}
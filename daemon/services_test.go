@@ -63,11 +63,11 @@ func (ds *DaemonSuite) TestServices(c *C) {
 	c.Assert(err, Equals, nil)
 	c.Assert(l3n4AddrID.ID, Equals, ffsIDu16+1)
 
-	// l3n4Addr3 should have the same ID as l3n4Addr2 since we are omitting the
-	// protocol type.
+	// l3n4Addr3 should have a different ID than l3n4Addr2 since the protocol
+	// types differ.
 	l3n4AddrID, err = ds.d.PutL3n4Addr(l3n4Addr3, 0)
 	c.Assert(err, Equals, nil)
-	c.Assert(l3n4AddrID.ID, Equals, ffsIDu16+1)
+	c.Assert(l3n4AddrID.ID, Equals, ffsIDu16+2)
 
 	gotL3n4AddrID, err := ds.d.GetL3n4AddrID(common.FirstFreeServiceID)
 	c.Assert(err, Equals, nil)
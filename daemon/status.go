@@ -102,5 +102,13 @@ func (h *getHealthz) Handle(params GetHealthzParams) middleware.Responder {
 
 	sr.IPAM = d.DumpIPAM()
 
+	for _, a := range d.conf.DeprecatedFlags {
+		sr.DeprecatedOptions = append(sr.DeprecatedOptions, &models.DeprecatedOption{
+			Name:        a.Name,
+			Replacement: a.Target,
+			RemovedIn:   a.RemovedIn,
+		})
+	}
+
 	return NewGetHealthzOK().WithPayload(&sr)
 }
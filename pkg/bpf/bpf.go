@@ -101,6 +101,7 @@ import (
 	"path/filepath"
 	"unsafe"
 
+	log "github.com/Sirupsen/logrus"
 	"golang.org/x/sys/unix"
 )
 
@@ -273,6 +274,10 @@ func ObjClose(fd int) error {
 	return nil
 }
 
+// OpenOrCreateMap opens the map pinned at path or creates and pins a new map
+// if none exists. A pinned map with a different type, key size or value size,
+// e.g. left behind by a previous version using a different layout, is removed
+// and replaced with a new map. Returns true if a new map has been created.
 func OpenOrCreateMap(path string, mapType int, keySize, valueSize, maxEntries uint32) (int, bool, error) {
 	var fd int
 
@@ -326,5 +331,26 @@ func OpenOrCreateMap(path string, mapType int, keySize, valueSize, maxEntries ui
 	}
 
 	fd, err = ObjGet(path)
-	return fd, isNewMap, err
+	if err != nil {
+		return 0, isNewMap, err
+	}
+
+	info, err := GetMapInfo(os.Getpid(), fd)
+	if err != nil || info.KeySize == 0 {
+		// Layout unknown, assume the map is compatible
+		return fd, isNewMap, nil
+	}
+
+	if info.MapType == MapType(mapType) && info.KeySize == keySize && info.ValueSize == valueSize {
+		return fd, isNewMap, nil
+	}
+
+	log.Warningf("Removing map %s with incompatible layout (%s, key size %d, value size %d), expected (%s, key size %d, value size %d)",
+		path, info.MapType, info.KeySize, info.ValueSize, MapType(mapType), keySize, valueSize)
+	ObjClose(fd)
+	if err = os.Remove(path); err != nil {
+		return 0, isNewMap, fmt.Errorf("Unable to remove map %s: %s", path, err)
+	}
+
+	return OpenOrCreateMap(path, mapType, keySize, valueSize, maxEntries)
 }
@@ -28,6 +28,7 @@ const (
 	MessageTypeDrop
 	MessageTypeDebug
 	MessageTypeCapture
+	MessageTypeFlow
 )
 
 // must be in sync with <bpf/lib/dbg.h>
@@ -0,0 +1,48 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bpfdebug
+
+import (
+	"fmt"
+
+	"github.com/cilium/cilium/common"
+	"github.com/cilium/cilium/pkg/u8proto"
+)
+
+// FlowNotify is the message format of a notification of a new allowed
+// connection in the BPF ring buffer, see <bpf/lib/flow.h>
+type FlowNotify struct {
+	Type     uint8
+	SubType  uint8
+	Source   uint16
+	Hash     uint32
+	SrcLabel uint32
+	DstLabel uint32
+	DPort    uint16
+	Nexthdr  uint8
+	Pad      uint8
+}
+
+// DstPort returns the destination port of the connection in host byte order
+func (n *FlowNotify) DstPort() uint16 {
+	return common.Swab16(n.DPort)
+}
+
+// Dump prints the flow notification in human readable form
+func (n *FlowNotify) Dump(prefix string) {
+	proto := u8proto.U8proto(n.Nexthdr)
+	fmt.Printf("%s MARK %#x FROM %d New connection %d->%d port %d/%s\n",
+		prefix, n.Hash, n.Source, n.SrcLabel, n.DstLabel, n.DstPort(), proto.String())
+}
@@ -15,7 +15,10 @@
 package client
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
@@ -57,8 +60,46 @@ func NewDefaultClient() (*Client, error) {
 	return NewClient("")
 }
 
+// NewTLSConfig returns the TLS configuration to connect to a remote agent.
+// The server certificate is verified against the CA in caFile or against the
+// system CAs if caFile is empty. The client certificate in certFile and
+// keyFile is optional.
+func NewTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caFile != "" {
+		ca, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificate found in %s", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
 // NewClient creates a client for the given `host`.
 func NewClient(host string) (*Client, error) {
+	return NewTLSClient(host, nil)
+}
+
+// NewTLSClient creates a client for the given `host`. Agents only serve the
+// API over TCP with TLS, connections to tcp hosts are therefore always secured
+// with TLS. If tlsConfig is nil, the server certificate is verified against
+// the system CAs.
+func NewTLSClient(host string, tlsConfig *tls.Config) (*Client, error) {
 	if host == "" {
 		// Check if environment variable points to socket
 		e := os.Getenv(defaults.SockPathEnv)
@@ -73,19 +114,26 @@ func NewClient(host string) (*Client, error) {
 		return nil, fmt.Errorf("invalid host format '%s'", host)
 	}
 
+	schemes := clientapi.DefaultSchemes
 	switch tmp[0] {
 	case "tcp":
 		if _, err := url.Parse("tcp://" + tmp[1]); err != nil {
 			return nil, err
 		}
-		host = "http://" + tmp[1]
+		host = tmp[1]
+		schemes = []string{"https"}
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
 	case "unix":
 		host = tmp[1]
+		tlsConfig = nil
 	}
 
 	transport := configureTransport(nil, tmp[0], host)
+	transport.TLSClientConfig = tlsConfig
 	httpClient := &http.Client{Transport: transport}
 	clientTrans := runtime_client.NewWithClient(host, clientapi.DefaultBasePath,
-		clientapi.DefaultSchemes, httpClient)
+		schemes, httpClient)
 	return &Client{*clientapi.New(clientTrans, strfmt.Default)}, nil
 }
@@ -0,0 +1,42 @@
+// Copyright 2016-2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"time"
+
+	"github.com/cilium/cilium/api/v1/client/daemon"
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+// NodeList returns the list of nodes registered in the kvstore
+func (c *Client) NodeList() ([]*models.Node, error) {
+	resp, err := c.Daemon.GetNode(nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Payload, nil
+}
+
+// StatusGet returns the daemon status, failing if it takes longer than
+// timeout.
+func (c *Client) StatusGet(timeout time.Duration) (*models.StatusResponse, error) {
+	params := daemon.NewGetHealthzParamsWithTimeout(timeout)
+	resp, err := c.Daemon.GetHealthz(params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Payload, nil
+}
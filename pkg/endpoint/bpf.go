@@ -202,6 +202,12 @@ func (e *Endpoint) writeHeaderfile(prefix string, owner Owner) error {
 	// Endpoint options
 	fw.WriteString(e.Opts.GetFmtList())
 
+	if e.SecLabel != nil {
+		if t := owner.GetMirrorTarget(e.ID, e.SecLabel.Labels.ToSlice()); t != nil {
+			t.WriteDefines(fw)
+		}
+	}
+
 	fw.WriteString("#define LXC_PORT_MAPPINGS ")
 	for _, m := range e.PortMap {
 		// Write mappings directly in network byte order so we don't have
@@ -59,6 +59,7 @@ const (
 	OptionConntrack           = "Conntrack"
 	OptionDebug               = "Debug"
 	OptionDropNotify          = "DropNotification"
+	OptionFlowNotify          = "FlowNotification"
 	OptionNAT46               = "NAT46"
 	OptionPolicy              = "Policy"
 
@@ -105,6 +106,11 @@ var (
 		Description: "Enable drop notifications",
 	}
 
+	OptionSpecFlowNotify = option.Option{
+		Define:      "FLOW_NOTIFY",
+		Description: "Enable notifications of new allowed connections",
+	}
+
 	OptionSpecNAT46 = option.Option{
 		Define:      "ENABLE_NAT46",
 		Description: "Enable automatic NAT46 translation",
@@ -129,6 +135,7 @@ var (
 		OptionConntrack:           &OptionSpecConntrack,
 		OptionDebug:               &OptionSpecDebug,
 		OptionDropNotify:          &OptionSpecDropNotify,
+		OptionFlowNotify:          &OptionSpecFlowNotify,
 		OptionNAT46:               &OptionSpecNAT46,
 		OptionPolicy:              &OptionSpecPolicy,
 	}
@@ -16,6 +16,7 @@ package endpoint
 
 import (
 	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/mirror"
 	"github.com/cilium/cilium/pkg/policy"
 	"github.com/cilium/cilium/pkg/proxy"
 )
@@ -67,6 +68,10 @@ type Owner interface {
 
 	// Returns true if debugging has been enabled
 	DebugEnabled() bool
+
+	// GetMirrorTarget must return the target to mirror the traffic of the
+	// endpoint with the given ID and labels to or nil
+	GetMirrorTarget(id uint16, lbls labels.LabelArray) *mirror.Target
 }
 
 // Request is used to create the endpoint's request and send it to the endpoints
@@ -0,0 +1,111 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flagalias allows renaming command line flags while continuing to
+// accept the old flag names for a number of releases.
+package flagalias
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Alias is a deprecated flag name which is accepted in place of the flag it
+// has been renamed to.
+type Alias struct {
+	// Name is the deprecated name of the flag
+	Name string
+
+	// Target is the name of the flag replacing the deprecated flag
+	Target string
+
+	// RemovedIn is the release (major.minor) in which the deprecated name
+	// stops being accepted
+	RemovedIn string
+}
+
+// Message returns the warning printed when the deprecated flag is used
+func (a *Alias) Message() string {
+	return fmt.Sprintf("use --%s instead, --%s will be removed in %s", a.Target, a.Name, a.RemovedIn)
+}
+
+func parseMajorMinor(version string) (int, int, error) {
+	versions := strings.SplitN(version, ".", 3)
+	if len(versions) < 2 {
+		return 0, 0, fmt.Errorf("unable to get version from %q", version)
+	}
+	major, err := strconv.Atoi(versions[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to get major version from %q", version)
+	}
+	minor, err := strconv.Atoi(versions[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to get minor version from %q", version)
+	}
+	return major, minor, nil
+}
+
+// Expired returns true if the alias is no longer to be accepted by the given
+// release version.
+func (a *Alias) Expired(version string) (bool, error) {
+	removedMajor, removedMinor, err := parseMajorMinor(a.RemovedIn)
+	if err != nil {
+		return false, err
+	}
+	major, minor, err := parseMajorMinor(version)
+	if err != nil {
+		return false, err
+	}
+	return major > removedMajor || (major == removedMajor && minor >= removedMinor), nil
+}
+
+// Register adds the deprecated flag names of all aliases to flags. The
+// deprecated flags share the value of their target flag and are hidden from
+// the usage message. The target flags must have been defined before.
+func Register(flags *pflag.FlagSet, aliases []Alias) error {
+	for _, a := range aliases {
+		target := flags.Lookup(a.Target)
+		if target == nil {
+			return fmt.Errorf("target flag %q of alias %q does not exist", a.Target, a.Name)
+		}
+		if flags.Lookup(a.Name) != nil {
+			return fmt.Errorf("alias %q is already defined as a flag", a.Name)
+		}
+
+		flags.AddFlag(&pflag.Flag{
+			Name:        a.Name,
+			Usage:       target.Usage,
+			Value:       target.Value,
+			DefValue:    target.DefValue,
+			NoOptDefVal: target.NoOptDefVal,
+			Deprecated:  a.Message(),
+		})
+	}
+
+	return nil
+}
+
+// Used returns all aliases which have been set in flags.
+func Used(flags *pflag.FlagSet, aliases []Alias) []Alias {
+	used := []Alias{}
+	for _, a := range aliases {
+		if flags.Changed(a.Name) {
+			used = append(used, a)
+		}
+	}
+	return used
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flagalias
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	. "gopkg.in/check.v1"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type FlagAliasSuite struct{}
+
+var _ = Suite(&FlagAliasSuite{})
+
+func newTestFlagSet() (*pflag.FlagSet, *string, *bool) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	device := flags.String("devices", "undefined", "Devices to snoop on")
+	debug := flags.Bool("debug", false, "Enable debug messages")
+	return flags, device, debug
+}
+
+func (s *FlagAliasSuite) TestRegister(c *C) {
+	aliases := []Alias{
+		{Name: "device", Target: "devices", RemovedIn: "1.0"},
+		{Name: "debugging", Target: "debug", RemovedIn: "1.0"},
+	}
+
+	flags, device, debug := newTestFlagSet()
+	c.Assert(Register(flags, aliases), IsNil)
+	c.Assert(flags.Parse([]string{"--device", "eth0", "--debugging"}), IsNil)
+	c.Assert(*device, Equals, "eth0")
+	c.Assert(*debug, Equals, true)
+	c.Assert(Used(flags, aliases), DeepEquals, aliases)
+
+	flags, device, _ = newTestFlagSet()
+	c.Assert(Register(flags, aliases), IsNil)
+	c.Assert(flags.Parse([]string{"--devices", "eth1"}), IsNil)
+	c.Assert(*device, Equals, "eth1")
+	c.Assert(Used(flags, aliases), DeepEquals, []Alias{})
+}
+
+func (s *FlagAliasSuite) TestRegisterInvalid(c *C) {
+	flags, _, _ := newTestFlagSet()
+	err := Register(flags, []Alias{{Name: "foo", Target: "bar", RemovedIn: "1.0"}})
+	c.Assert(err, Not(IsNil))
+
+	flags, _, _ = newTestFlagSet()
+	err = Register(flags, []Alias{{Name: "debug", Target: "devices", RemovedIn: "1.0"}})
+	c.Assert(err, Not(IsNil))
+}
+
+func (s *FlagAliasSuite) TestExpired(c *C) {
+	a := Alias{Name: "device", Target: "devices", RemovedIn: "1.2"}
+
+	for version, expired := range map[string]bool{
+		"0.8.90": false,
+		"1.1.0":  false,
+		"1.2.0":  true,
+		"1.3":    true,
+		"2.0.0":  true,
+	} {
+		res, err := a.Expired(version)
+		c.Assert(err, IsNil)
+		c.Assert(res, Equals, expired, Commentf("version %s", version))
+	}
+
+	_, err := a.Expired("dev")
+	c.Assert(err, Not(IsNil))
+
+	a.RemovedIn = "next"
+	_, err = a.Expired("1.0.0")
+	c.Assert(err, Not(IsNil))
+}
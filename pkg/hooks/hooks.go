@@ -0,0 +1,276 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hooks notifies external systems of endpoint lifecycle events by
+// posting the events to a webhook or by executing a program.
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// EventType is the type of an endpoint lifecycle event
+type EventType string
+
+const (
+	// EndpointCreate is the event type used when an endpoint is created
+	EndpointCreate EventType = "endpoint-create"
+
+	// EndpointDelete is the event type used when an endpoint is deleted
+	EndpointDelete EventType = "endpoint-delete"
+
+	// EndpointIdentityChange is the event type used when the security
+	// identity of an endpoint changes
+	EndpointIdentityChange EventType = "endpoint-identity-change"
+
+	// DefaultTimeout is the default timeout of a single delivery attempt
+	DefaultTimeout = 5 * time.Second
+
+	// DefaultRetries is the default number of retries of a failed delivery
+	DefaultRetries = 3
+
+	// DefaultRetryInterval is the default interval before the first retry,
+	// the interval doubles with every retry
+	DefaultRetryInterval = time.Second
+
+	// queueSize is the maximum number of events waiting for delivery
+	queueSize = 1024
+)
+
+// Endpoint is the state of the endpoint an event refers to
+type Endpoint struct {
+	ID               uint16   `json:"id"`
+	ContainerID      string   `json:"container-id,omitempty"`
+	DockerEndpointID string   `json:"docker-endpoint-id,omitempty"`
+	IPv4             string   `json:"ipv4,omitempty"`
+	IPv6             string   `json:"ipv6,omitempty"`
+	Identity         uint32   `json:"identity,omitempty"`
+	Labels           []string `json:"labels,omitempty"`
+}
+
+// Event is the JSON payload delivered to the hook
+type Event struct {
+	Type      EventType `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Node      string    `json:"node,omitempty"`
+	Endpoint  *Endpoint `json:"endpoint"`
+
+	// PreviousIdentity is the identity of the endpoint before an identity
+	// change
+	PreviousIdentity uint32 `json:"previous-identity,omitempty"`
+}
+
+// NewEvent creates a new event of type t for the given endpoint
+func NewEvent(t EventType, ep *Endpoint) *Event {
+	return &Event{
+		Type:      t,
+		Timestamp: time.Now(),
+		Endpoint:  ep,
+	}
+}
+
+// Config is the configuration of the endpoint lifecycle hook. Events are
+// posted to URL and/or passed to the standard input of Exec. Hooks are
+// disabled if neither is set.
+type Config struct {
+	// URL is the address of the webhook events are posted to
+	URL string
+
+	// Exec is the path to a program executed for every event. The event
+	// type is passed as argument and the event as JSON on standard input.
+	Exec string
+
+	// Timeout is the timeout of a single delivery attempt
+	Timeout time.Duration
+
+	// Retries is the number of retries of a failed delivery
+	Retries int
+
+	// RetryInterval is the interval before the first retry, it doubles
+	// with every retry
+	RetryInterval time.Duration
+}
+
+// sink is a destination events are delivered to
+type sink interface {
+	// deliver makes a single attempt to deliver the event payload of type t
+	deliver(t EventType, payload []byte) error
+
+	String() string
+}
+
+// webhook posts events to a URL
+type webhook struct {
+	url    string
+	client *http.Client
+}
+
+func (w *webhook) deliver(t EventType, payload []byte) error {
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %s", resp.Status)
+	}
+
+	return nil
+}
+
+func (w *webhook) String() string {
+	return "webhook " + w.url
+}
+
+// program executes a program for every event
+type program struct {
+	path    string
+	timeout time.Duration
+}
+
+func (p *program) deliver(t EventType, payload []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, p.path, string(t))
+	cmd.Stdin = bytes.NewReader(payload)
+	out, err := cmd.CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("timed out after %s", p.timeout)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %s", err, bytes.TrimSpace(out))
+	}
+
+	return nil
+}
+
+func (p *program) String() string {
+	return "program " + p.path
+}
+
+// Dispatcher delivers events to the configured hooks in the order in which
+// they have been queued. A nil Dispatcher discards all events.
+type Dispatcher struct {
+	conf  Config
+	sinks []sink
+	queue chan *Event
+}
+
+// newSinks returns the sinks configured in conf
+func newSinks(conf Config) []sink {
+	sinks := []sink{}
+	if conf.URL != "" {
+		sinks = append(sinks, &webhook{
+			url:    conf.URL,
+			client: &http.Client{Timeout: conf.Timeout},
+		})
+	}
+	if conf.Exec != "" {
+		sinks = append(sinks, &program{path: conf.Exec, timeout: conf.Timeout})
+	}
+	return sinks
+}
+
+// NewDispatcher starts delivering events to the hooks in conf. Returns nil
+// if no hook is configured.
+func NewDispatcher(conf Config) (*Dispatcher, error) {
+	sinks := newSinks(conf)
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+
+	if conf.Timeout <= 0 {
+		return nil, fmt.Errorf("hook timeout must be greater than 0")
+	}
+
+	if conf.Retries < 0 {
+		return nil, fmt.Errorf("hook retries must not be negative")
+	}
+
+	d := &Dispatcher{
+		conf:  conf,
+		sinks: sinks,
+		queue: make(chan *Event, queueSize),
+	}
+
+	go d.run()
+
+	return d, nil
+}
+
+// Notify queues ev for delivery. Events are dropped if the queue is full.
+func (d *Dispatcher) Notify(ev *Event) {
+	if d == nil {
+		return
+	}
+
+	select {
+	case d.queue <- ev:
+	default:
+		log.Warningf("Hook queue is full, dropping %s event of endpoint %d", ev.Type, ev.Endpoint.ID)
+	}
+}
+
+func (d *Dispatcher) run() {
+	for ev := range d.queue {
+		d.deliver(ev)
+	}
+}
+
+// deliver delivers ev to all hooks. Every hook is retried on its own so
+// that a failing hook neither delays nor duplicates the delivery to the
+// others. Returns the number of hooks the event could not be delivered to.
+func (d *Dispatcher) deliver(ev *Event) int {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		log.Warningf("Unable to encode %s event of endpoint %d: %s", ev.Type, ev.Endpoint.ID, err)
+		return len(d.sinks)
+	}
+
+	failed := 0
+	for _, s := range d.sinks {
+		if err := d.deliverTo(s, ev.Type, payload); err != nil {
+			log.Warningf("Unable to deliver %s event of endpoint %d to %s: %s", ev.Type, ev.Endpoint.ID, s, err)
+			failed++
+		}
+	}
+
+	return failed
+}
+
+// deliverTo delivers the event payload of type t to s, retrying failed
+// attempts with a doubling interval
+func (d *Dispatcher) deliverTo(s sink, t EventType, payload []byte) error {
+	interval := d.conf.RetryInterval
+	for attempt := 0; ; attempt++ {
+		err := s.deliver(t, payload)
+		if err == nil || attempt >= d.conf.Retries {
+			return err
+		}
+
+		log.Debugf("Delivery of %s event to %s failed, retrying in %s: %s", t, s, interval, err)
+		time.Sleep(interval)
+		interval *= 2
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type HooksSuite struct{}
+
+var _ = Suite(&HooksSuite{})
+
+func newTestConfig() Config {
+	return Config{
+		Timeout:       time.Second,
+		Retries:       DefaultRetries,
+		RetryInterval: time.Millisecond,
+	}
+}
+
+func newTestDispatcher(conf Config) *Dispatcher {
+	return &Dispatcher{conf: conf, sinks: newSinks(conf)}
+}
+
+func newTestEvent() *Event {
+	return NewEvent(EndpointCreate, &Endpoint{ID: 42, IPv6: "f00d::1", Identity: 256})
+}
+
+func (s *HooksSuite) TestWebhookRetry(c *C) {
+	requests := 0
+	var received Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&received)
+	}))
+	defer srv.Close()
+
+	conf := newTestConfig()
+	conf.URL = srv.URL
+	d := newTestDispatcher(conf)
+
+	c.Assert(d.deliver(newTestEvent()), Equals, 0)
+	c.Assert(requests, Equals, 3)
+	c.Assert(received.Type, Equals, EndpointCreate)
+	c.Assert(received.Endpoint.ID, Equals, uint16(42))
+	c.Assert(received.Endpoint.Identity, Equals, uint32(256))
+
+	requests = 0
+	d.conf.Retries = 1
+	c.Assert(d.deliver(newTestEvent()), Equals, 1)
+	c.Assert(requests, Equals, 2)
+}
+
+func (s *HooksSuite) TestExec(c *C) {
+	dir, err := ioutil.TempDir("", "hooks")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+
+	out := filepath.Join(dir, "event")
+	script := filepath.Join(dir, "hook.sh")
+	err = ioutil.WriteFile(script, []byte("#!/bin/sh\necho \"$1\" > "+out+"\ncat >> "+out+"\n"), 0755)
+	c.Assert(err, IsNil)
+
+	conf := newTestConfig()
+	conf.Exec = script
+	d := newTestDispatcher(conf)
+	c.Assert(d.deliver(newTestEvent()), Equals, 0)
+
+	content, err := ioutil.ReadFile(out)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Matches, "endpoint-create\n\\{\"type\":\"endpoint-create\".*\"id\":42.*")
+
+	conf.Retries = 0
+	conf.Exec = "/bin/false"
+	d = newTestDispatcher(conf)
+	c.Assert(d.deliver(newTestEvent()), Equals, 1)
+}
+
+func (s *HooksSuite) TestExecTimeout(c *C) {
+	dir, err := ioutil.TempDir("", "hooks")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+
+	conf := newTestConfig()
+	conf.Retries = 0
+	conf.Timeout = 10 * time.Millisecond
+	conf.Exec = filepath.Join(dir, "hook.sh")
+	c.Assert(ioutil.WriteFile(conf.Exec, []byte("#!/bin/sh\nexec sleep 5\n"), 0755), IsNil)
+
+	d := newTestDispatcher(conf)
+	start := time.Now()
+	c.Assert(d.deliver(newTestEvent()), Equals, 1)
+	c.Assert(time.Since(start) < 5*time.Second, Equals, true)
+}
+
+func (s *HooksSuite) TestRetryFailedHookOnly(c *C) {
+	dir, err := ioutil.TempDir("", "hooks")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer srv.Close()
+
+	// The program counts its invocations and always fails
+	out := filepath.Join(dir, "count")
+	script := filepath.Join(dir, "hook.sh")
+	err = ioutil.WriteFile(script, []byte("#!/bin/sh\necho x >> "+out+"\nexit 1\n"), 0755)
+	c.Assert(err, IsNil)
+
+	conf := newTestConfig()
+	conf.URL = srv.URL
+	conf.Exec = script
+	d := newTestDispatcher(conf)
+	c.Assert(d.deliver(newTestEvent()), Equals, 1)
+
+	// The webhook succeeded on the first attempt and must not see the
+	// retries of the program
+	c.Assert(requests, Equals, 1)
+	content, err := ioutil.ReadFile(out)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, strings.Repeat("x\n", conf.Retries+1))
+}
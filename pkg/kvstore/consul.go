@@ -175,6 +175,19 @@ func (c *ConsulClient) GetValue(k string) (json.RawMessage, error) {
 	return json.RawMessage(pair.Value), nil
 }
 
+func (c *ConsulClient) ListPrefix(prefix string) (map[string]json.RawMessage, error) {
+	pairs, _, err := c.KV().List(prefix, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]json.RawMessage{}
+	for _, pair := range pairs {
+		values[pair.Key] = json.RawMessage(pair.Value)
+	}
+	return values, nil
+}
+
 // GetMaxID returns the maximum possible free UUID stored in consul.
 func (c *ConsulClient) GetMaxID(key string, firstID uint32) (uint32, error) {
 	k, _, err := c.KV().Get(key, nil)
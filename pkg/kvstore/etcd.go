@@ -150,6 +150,19 @@ func (e *EtcdClient) GetValue(k string) (json.RawMessage, error) {
 	return json.RawMessage(gresp.Kvs[0].Value), nil
 }
 
+func (e *EtcdClient) ListPrefix(prefix string) (map[string]json.RawMessage, error) {
+	gresp, err := e.cli.Get(ctx.Background(), prefix, client.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]json.RawMessage{}
+	for _, kv := range gresp.Kvs {
+		values[string(kv.Key)] = json.RawMessage(kv.Value)
+	}
+	return values, nil
+}
+
 func (e *EtcdClient) SetValue(k string, v interface{}) error {
 	vByte, err := json.Marshal(v)
 	if err != nil {
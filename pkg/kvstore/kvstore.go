@@ -33,6 +33,8 @@ const (
 type KVClient interface {
 	LockPath(path string) (KVLocker, error)
 	GetValue(k string) (json.RawMessage, error)
+	// ListPrefix returns all values with a key starting with prefix
+	ListPrefix(prefix string) (map[string]json.RawMessage, error)
 	SetValue(k string, v interface{}) error
 	InitializeFreeID(path string, firstID uint32) error
 	GetMaxID(key string, firstID uint32) (uint32, error)
@@ -60,6 +60,19 @@ func (l *LocalClient) GetValue(k string) (json.RawMessage, error) {
 	return nil, nil
 }
 
+func (l *LocalClient) ListPrefix(prefix string) (map[string]json.RawMessage, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	values := map[string]json.RawMessage{}
+	for k, v := range l.store {
+		if strings.HasPrefix(k, prefix) {
+			values[k] = json.RawMessage(v)
+		}
+	}
+	return values, nil
+}
+
 func (l *LocalClient) SetValue(k string, v interface{}) error {
 	vByte, err := json.Marshal(v)
 	if err != nil {
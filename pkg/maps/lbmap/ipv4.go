@@ -24,6 +24,7 @@ import (
 	"github.com/cilium/cilium/common"
 	"github.com/cilium/cilium/common/types"
 	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/u8proto"
 )
 
 var (
@@ -49,6 +50,8 @@ type Service4Key struct {
 	Address types.IPv4
 	Port    uint16
 	Slave   uint16
+	Proto   u8proto.U8proto
+	Pad     uint8
 }
 
 func (k Service4Key) IsIPv6() bool               { return false }
@@ -57,12 +60,13 @@ func (k Service4Key) RRMap() *bpf.Map            { return RRSeq4Map }
 func (k Service4Key) NewValue() bpf.MapValue     { return &Service4Value{} }
 func (k *Service4Key) GetKeyPtr() unsafe.Pointer { return unsafe.Pointer(k) }
 func (k *Service4Key) GetPort() uint16           { return k.Port }
+func (k *Service4Key) GetProtocol() types.L4Type { return proto2L4Type(k.Proto) }
 func (k *Service4Key) SetPort(port uint16)       { k.Port = port }
 func (k *Service4Key) SetBackend(backend int)    { k.Slave = uint16(backend) }
 func (k *Service4Key) GetBackend() int           { return int(k.Slave) }
 
 func (k *Service4Key) String() string {
-	return fmt.Sprintf("%s:%d", k.Address, k.Port)
+	return fmt.Sprintf("%s:%d%s", k.Address, k.Port, protoSuffix(k.Proto))
 }
 
 func (k *Service4Key) Convert() ServiceKey {
@@ -75,10 +79,11 @@ func (k *Service4Key) MapDelete() error {
 	return k.Map().Delete(k)
 }
 
-func NewService4Key(ip net.IP, port uint16, slave uint16) *Service4Key {
+func NewService4Key(ip net.IP, port uint16, proto u8proto.U8proto, slave uint16) *Service4Key {
 	key := Service4Key{
 		Port:  port,
 		Slave: slave,
+		Proto: proto,
 	}
 
 	copy(key.Address[:], ip.To4())
@@ -94,6 +99,8 @@ func (k *Service4Key) RevNatValue() RevNatValue {
 }
 
 // Service4Value must match 'struct lb4_service' in "bpf/lib/common.h".
+// Count is the number of backends of the master entry and the drain slave of
+// backend entries.
 type Service4Value struct {
 	Address types.IPv4
 	Port    uint16
@@ -119,6 +126,8 @@ func (s *Service4Value) GetValuePtr() unsafe.Pointer { return unsafe.Pointer(s)
 func (s *Service4Value) SetPort(port uint16)         { s.Port = port }
 func (s *Service4Value) SetCount(count int)          { s.Count = uint16(count) }
 func (s *Service4Value) GetCount() int               { return int(s.Count) }
+func (s *Service4Value) SetDrainSlave(slave int)     { s.Count = uint16(slave) }
+func (s *Service4Value) GetDrainSlave() int          { return int(s.Count) }
 func (s *Service4Value) SetRevNat(id int)            { s.RevNat = uint16(id) }
 func (s *Service4Value) SetWeight(weight uint16)     { s.Weight = weight }
 func (s *Service4Value) GetWeight() uint16           { return s.Weight }
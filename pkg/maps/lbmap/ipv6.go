@@ -24,6 +24,7 @@ import (
 	"github.com/cilium/cilium/common"
 	"github.com/cilium/cilium/common/types"
 	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/u8proto"
 )
 
 var (
@@ -49,12 +50,15 @@ type Service6Key struct {
 	Address types.IPv6
 	Port    uint16
 	Slave   uint16
+	Proto   u8proto.U8proto
+	Pad     uint8
 }
 
-func NewService6Key(ip net.IP, port uint16, slave uint16) *Service6Key {
+func NewService6Key(ip net.IP, port uint16, proto u8proto.U8proto, slave uint16) *Service6Key {
 	key := Service6Key{
 		Port:  port,
 		Slave: slave,
+		Proto: proto,
 	}
 
 	copy(key.Address[:], ip.To16())
@@ -68,6 +72,7 @@ func (k Service6Key) RRMap() *bpf.Map            { return RRSeq6Map }
 func (k Service6Key) NewValue() bpf.MapValue     { return &Service6Value{} }
 func (k *Service6Key) GetKeyPtr() unsafe.Pointer { return unsafe.Pointer(k) }
 func (k *Service6Key) GetPort() uint16           { return k.Port }
+func (k *Service6Key) GetProtocol() types.L4Type { return proto2L4Type(k.Proto) }
 func (k *Service6Key) SetPort(port uint16)       { k.Port = port }
 func (k *Service6Key) SetBackend(backend int)    { k.Slave = uint16(backend) }
 func (k *Service6Key) GetBackend() int           { return int(k.Slave) }
@@ -79,7 +84,7 @@ func (k *Service6Key) Convert() ServiceKey {
 }
 
 func (k *Service6Key) String() string {
-	return fmt.Sprintf("[%s]:%d", k.Address, k.Port)
+	return fmt.Sprintf("[%s]:%d%s", k.Address, k.Port, protoSuffix(k.Proto))
 }
 
 func (k *Service6Key) RevNatValue() RevNatValue {
@@ -90,6 +95,8 @@ func (k *Service6Key) RevNatValue() RevNatValue {
 }
 
 // Service6Value must match 'struct lb6_service' in "bpf/lib/common.h".
+// Count is the number of backends of the master entry and the drain slave of
+// backend entries.
 type Service6Value struct {
 	Address types.IPv6
 	Port    uint16
@@ -115,6 +122,8 @@ func (s *Service6Value) GetValuePtr() unsafe.Pointer { return unsafe.Pointer(s)
 func (s *Service6Value) SetPort(port uint16)         { s.Port = port }
 func (s *Service6Value) SetCount(count int)          { s.Count = uint16(count) }
 func (s *Service6Value) GetCount() int               { return int(s.Count) }
+func (s *Service6Value) SetDrainSlave(slave int)     { s.Count = uint16(slave) }
+func (s *Service6Value) GetDrainSlave() int          { return int(s.Count) }
 func (s *Service6Value) SetRevNat(id int)            { s.RevNat = uint16(id) }
 func (s *Service6Value) RevNatKey() RevNatKey        { return &RevNat6Key{s.RevNat} }
 func (s *Service6Value) SetWeight(weight uint16)     { s.Weight = weight }
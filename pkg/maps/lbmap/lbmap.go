@@ -21,6 +21,9 @@ import (
 
 	"github.com/cilium/cilium/common/types"
 	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/u8proto"
+
+	log "github.com/Sirupsen/logrus"
 )
 
 const (
@@ -53,6 +56,9 @@ type ServiceKey interface {
 	// Returns the port set in the key or 0
 	GetPort() uint16
 
+	// Returns the protocol set in the key or NONE
+	GetProtocol() types.L4Type
+
 	// Set the backend index (master: 0, backend: nth backend)
 	SetBackend(int)
 
@@ -79,6 +85,14 @@ type ServiceValue interface {
 	// Get the number of backends
 	GetCount() int
 
+	// Set the backend to which new connections are redirected while
+	// draining this backend, 0 if the backend is active
+	SetDrainSlave(int)
+
+	// Get the backend to which new connections are redirected while
+	// draining this backend or 0
+	GetDrainSlave() int
+
 	// Set address to map to (left blank for master)
 	SetAddress(net.IP) error
 
@@ -119,11 +133,22 @@ func UpdateService(key ServiceKey, value ServiceValue) error {
 	return key.Map().Update(key.Convert(), value.Convert())
 }
 
+// DeleteService deletes the service key, which must be the master entry, and
+// its backend entries.
 func DeleteService(key ServiceKey) error {
+	count := 0
+	if svc, err := LookupService(key); err == nil {
+		count = svc.GetCount()
+	}
+
 	err := key.Map().Delete(key.Convert())
 	if err != nil {
 		return err
 	}
+
+	deleteServiceSlaves(key, 1, count)
+	key.SetBackend(0)
+
 	return LookupAndDeleteServiceWeights(key)
 }
 
@@ -295,10 +320,30 @@ func UpdateWrrSeq(fe ServiceKey, weights []uint16) error {
 	return UpdateServiceWeights(fe, svcRRSeq)
 }
 
-// AddSVC2BPFMap adds the given bpf service to the bpf maps.
+// deleteServiceSlaves removes the backend entries first to last of the
+// service fe from the services map.
+func deleteServiceSlaves(fe ServiceKey, first, last int) {
+	for slave := first; slave <= last; slave++ {
+		fe.SetBackend(slave)
+		if err := fe.Map().Delete(fe.Convert()); err != nil {
+			log.Debugf("Unable to delete backend %d of service %s: %s", slave, fe.String(), err)
+		}
+	}
+}
+
+// AddSVC2BPFMap adds the given bpf service to the bpf maps. Backend entries
+// of a previous version of the service beyond the new number of backends are
+// removed.
 func AddSVC2BPFMap(fe ServiceKey, besValues []ServiceValue, addRevNAT bool, revNATID int) error {
 	var err error
 	var weights []uint16
+
+	prevCount := 0
+	fe.SetBackend(0)
+	if prev, err := LookupService(fe); err == nil {
+		prevCount = prev.GetCount()
+	}
+
 	// Put all the backend services first
 	nSvcs := 1
 	nNonZeroWeights := 0
@@ -344,16 +389,52 @@ func AddSVC2BPFMap(fe ServiceKey, besValues []ServiceValue, addRevNAT bool, revN
 		return fmt.Errorf("unable to update service weights for %s with value %+v: %s", fe.String(), weights, err)
 	}
 
+	// The master no longer refers to the slaves beyond its count
+	if prevCount > nSvcs-1 {
+		deleteServiceSlaves(fe, nSvcs, prevCount)
+		fe.SetBackend(0)
+	}
+
 	return nil
 }
 
+// l4Type2Proto returns the protocol number stored in the service key for the
+// given L4 type. NONE is stored as 0 and matches all protocols.
+func l4Type2Proto(l4Type types.L4Type) u8proto.U8proto {
+	proto, err := u8proto.ParseProtocol(string(l4Type))
+	if err != nil {
+		return 0
+	}
+	return proto
+}
+
+// proto2L4Type returns the L4 type for the protocol number stored in a
+// service key.
+func proto2L4Type(proto u8proto.U8proto) types.L4Type {
+	l4Type, err := types.NewL4Type(proto.String())
+	if err != nil {
+		return types.NONE
+	}
+	return l4Type
+}
+
+// protoSuffix returns the "/PROTO" suffix of the string representation of a
+// service key or an empty string if the key matches all protocols.
+func protoSuffix(proto u8proto.U8proto) string {
+	if proto == 0 {
+		return ""
+	}
+	return "/" + proto.String()
+}
+
 // L3n4Addr2ServiceKey converts the given l3n4Addr to a ServiceKey with the slave ID
 // set to 0.
 func L3n4Addr2ServiceKey(l3n4Addr types.L3n4Addr) ServiceKey {
+	proto := l4Type2Proto(l3n4Addr.Protocol)
 	if l3n4Addr.IsIPv6() {
-		return NewService6Key(l3n4Addr.IP, l3n4Addr.Port, 0)
+		return NewService6Key(l3n4Addr.IP, l3n4Addr.Port, proto, 0)
 	}
-	return NewService4Key(l3n4Addr.IP, l3n4Addr.Port, 0)
+	return NewService4Key(l3n4Addr.IP, l3n4Addr.Port, proto, 0)
 }
 
 // LBSVC2ServiceKeynValue transforms the SVC cilium type into a bpf SVC type.
@@ -365,6 +446,12 @@ func LBSVC2ServiceKeynValue(svc types.LBSVC) (ServiceKey, []ServiceValue, error)
 	// map
 	besValues := []ServiceValue{}
 	for _, be := range svc.BES {
+		// The SCTP checksum does not allow for the port to be rewritten
+		if svc.FE.Protocol == types.SCTP && be.Port != 0 && be.Port != svc.FE.Port {
+			return nil, nil, fmt.Errorf("SCTP backend %s port %d must match frontend port %d",
+				be.IP, be.Port, svc.FE.Port)
+		}
+
 		beValue := fe.NewValue().(ServiceValue)
 		if err := beValue.SetAddress(be.IP); err != nil {
 			return nil, nil, err
@@ -403,7 +490,7 @@ func ServiceKey2L3n4Addr(svcKey ServiceKey) (*types.L3n4Addr, error) {
 		fePort = svc4Key.Port
 	}
 
-	return types.NewL3n4Addr(types.TCP, feIP, fePort)
+	return types.NewL3n4Addr(svcKey.GetProtocol(), feIP, fePort)
 }
 
 // ServiceKeynValue2FEnBE converts the given svcKey and svcValue to a frontend in the
@@ -0,0 +1,68 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package lbmap
+
+import (
+	"net"
+	"testing"
+
+	"github.com/cilium/cilium/common/types"
+
+	. "gopkg.in/check.v1"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type LBMapTestSuite struct{}
+
+var _ = Suite(&LBMapTestSuite{})
+
+func newTestSVC(proto types.L4Type, fePort uint16, bePorts ...uint16) types.LBSVC {
+	svc := types.LBSVC{
+		FE: types.L3n4AddrID{
+			L3n4Addr: types.L3n4Addr{
+				IP:     net.ParseIP("10.0.0.1"),
+				L4Addr: types.L4Addr{Protocol: proto, Port: fePort},
+			},
+			ID: 1,
+		},
+	}
+	for _, port := range bePorts {
+		svc.BES = append(svc.BES, types.LBBackEnd{
+			L3n4Addr: types.L3n4Addr{
+				IP:     net.ParseIP("10.1.0.1"),
+				L4Addr: types.L4Addr{Protocol: proto, Port: port},
+			},
+		})
+	}
+	return svc
+}
+
+func (s *LBMapTestSuite) TestLBSVC2ServiceKeynValueSCTP(c *C) {
+	_, bes, err := LBSVC2ServiceKeynValue(newTestSVC(types.SCTP, 2905, 2905, 0))
+	c.Assert(err, IsNil)
+	c.Assert(len(bes), Equals, 2)
+
+	_, _, err = LBSVC2ServiceKeynValue(newTestSVC(types.SCTP, 2905, 2905, 3000))
+	c.Assert(err, Not(IsNil))
+
+	// Port translation remains possible for TCP
+	_, bes, err = LBSVC2ServiceKeynValue(newTestSVC(types.TCP, 80, 8080))
+	c.Assert(err, IsNil)
+	c.Assert(len(bes), Equals, 1)
+	c.Assert(bes[0].(*Service4Value).Port, Equals, uint16(8080))
+}
@@ -0,0 +1,133 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/cilium/cilium/pkg/labels"
+)
+
+const (
+	// DefaultSampleRate mirrors every packet
+	DefaultSampleRate = 1
+
+	// DefaultRateLimit is the default maximum number of packets mirrored
+	// per second and CPU
+	DefaultRateLimit = 1000
+)
+
+// Config is the node wide configuration of traffic mirroring. Traffic of all
+// endpoints carrying the labels in Selector is duplicated to Collector.
+type Config struct {
+	// Collector is either the name of a network interface or the ID of a
+	// local endpoint to which mirrored packets are sent. Packets are not
+	// encapsulated by the datapath, a tunnel device (e.g. gretap, vxlan)
+	// must be used to forward them to a remote collector.
+	Collector string
+
+	// Selector is the list of labels an endpoint must carry for its
+	// traffic to be mirrored. An empty selector selects all endpoints.
+	Selector labels.LabelArray
+
+	// SampleRate mirrors one out of SampleRate packets
+	SampleRate uint32
+
+	// RateLimit is the maximum number of packets mirrored per second and
+	// CPU, 0 disables rate limiting
+	RateLimit uint32
+}
+
+// NewConfig returns a mirror configuration with default sampling and rate
+// limiting settings. Mirroring is disabled until a collector is set.
+func NewConfig() *Config {
+	return &Config{
+		Selector:   labels.LabelArray{},
+		SampleRate: DefaultSampleRate,
+		RateLimit:  DefaultRateLimit,
+	}
+}
+
+// Enabled returns true if a collector has been configured
+func (c *Config) Enabled() bool {
+	return c != nil && c.Collector != ""
+}
+
+// Validate returns an error if the configuration is invalid
+func (c *Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	if c.SampleRate == 0 {
+		return fmt.Errorf("mirror sample rate must be greater than 0")
+	}
+
+	return nil
+}
+
+// CollectorEndpointID returns the endpoint ID and true if the collector
+// refers to a local endpoint instead of a network interface.
+func (c *Config) CollectorEndpointID() (uint16, bool) {
+	id, err := strconv.ParseUint(c.Collector, 10, 16)
+	if err != nil {
+		return 0, false
+	}
+	return uint16(id), true
+}
+
+// Selects returns true if the traffic of an endpoint with the given labels
+// is to be mirrored.
+func (c *Config) Selects(lbls labels.LabelArray) bool {
+	if !c.Enabled() {
+		return false
+	}
+	return lbls.Contains(c.Selector)
+}
+
+// Target is the resolved destination of the mirrored traffic of a single
+// endpoint.
+type Target struct {
+	// IfIndex is the interface index the mirrored packets are cloned to
+	IfIndex int
+
+	// SampleRate mirrors one out of SampleRate packets
+	SampleRate uint32
+
+	// RateLimit is the maximum number of packets mirrored per second and
+	// CPU, 0 disables rate limiting
+	RateLimit uint32
+}
+
+// NewTarget returns a mirror target sending packets to ifindex using the
+// sampling and rate limiting settings of c.
+func (c *Config) NewTarget(ifindex int) *Target {
+	return &Target{
+		IfIndex:    ifindex,
+		SampleRate: c.SampleRate,
+		RateLimit:  c.RateLimit,
+	}
+}
+
+// WriteDefines writes the BPF defines enabling mirroring as implemented in
+// bpf/lib/mirror.h
+func (t *Target) WriteDefines(w io.Writer) {
+	fmt.Fprint(w, "#define ENABLE_MIRROR\n")
+	fmt.Fprintf(w, "#define MIRROR_IFINDEX %d\n", t.IfIndex)
+	fmt.Fprintf(w, "#define MIRROR_SAMPLE_RATE %d\n", t.SampleRate)
+	fmt.Fprintf(w, "#define MIRROR_RATE_LIMIT %d\n", t.RateLimit)
+}
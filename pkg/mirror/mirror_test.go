@@ -0,0 +1,102 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cilium/cilium/pkg/labels"
+
+	. "gopkg.in/check.v1"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type MirrorSuite struct{}
+
+var _ = Suite(&MirrorSuite{})
+
+func (s *MirrorSuite) TestEnabled(c *C) {
+	var nilCfg *Config
+	c.Assert(nilCfg.Enabled(), Equals, false)
+
+	cfg := NewConfig()
+	c.Assert(cfg.Enabled(), Equals, false)
+	c.Assert(cfg.Selects(labels.ParseLabelArray("id.foo")), Equals, false)
+
+	cfg.Collector = "eth1"
+	c.Assert(cfg.Enabled(), Equals, true)
+}
+
+func (s *MirrorSuite) TestValidate(c *C) {
+	cfg := NewConfig()
+	cfg.SampleRate = 0
+	c.Assert(cfg.Validate(), IsNil)
+
+	cfg.Collector = "eth1"
+	c.Assert(cfg.Validate(), Not(IsNil))
+
+	cfg.SampleRate = 10
+	c.Assert(cfg.Validate(), IsNil)
+}
+
+func (s *MirrorSuite) TestCollectorEndpointID(c *C) {
+	cfg := NewConfig()
+
+	cfg.Collector = "eth1"
+	_, ok := cfg.CollectorEndpointID()
+	c.Assert(ok, Equals, false)
+
+	cfg.Collector = "4242"
+	id, ok := cfg.CollectorEndpointID()
+	c.Assert(ok, Equals, true)
+	c.Assert(id, Equals, uint16(4242))
+
+	cfg.Collector = "70000"
+	_, ok = cfg.CollectorEndpointID()
+	c.Assert(ok, Equals, false)
+}
+
+func (s *MirrorSuite) TestSelects(c *C) {
+	cfg := NewConfig()
+	cfg.Collector = "eth1"
+
+	lbls := labels.ParseLabelArray("id.foo", "id.bar")
+	c.Assert(cfg.Selects(lbls), Equals, true)
+
+	cfg.Selector = labels.ParseLabelArray("id.foo")
+	c.Assert(cfg.Selects(lbls), Equals, true)
+
+	cfg.Selector = labels.ParseLabelArray("id.foo", "id.baz")
+	c.Assert(cfg.Selects(lbls), Equals, false)
+}
+
+func (s *MirrorSuite) TestWriteDefines(c *C) {
+	cfg := NewConfig()
+	cfg.SampleRate = 4
+	cfg.RateLimit = 100
+
+	var buf bytes.Buffer
+	cfg.NewTarget(12).WriteDefines(&buf)
+	c.Assert(buf.String(), Equals, ""+
+		"#define ENABLE_MIRROR\n"+
+		"#define MIRROR_IFINDEX 12\n"+
+		"#define MIRROR_SAMPLE_RATE 4\n"+
+		"#define MIRROR_RATE_LIMIT 100\n")
+}
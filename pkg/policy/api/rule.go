@@ -169,7 +169,7 @@ type PortProtocol struct {
 	Port string `json:"port"`
 
 	// Protocol is the L4 protocol. If omitted or empty, any protocol
-	// matches. Accepted values: "tcp", "udp", ""/"any"
+	// matches. Accepted values: "tcp", "udp", "sctp", ""/"any"
 	//
 	// Matching on ICMP is not supported.
 	//
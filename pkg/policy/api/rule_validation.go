@@ -65,6 +65,10 @@ func (pr PortRule) Validate() error {
 		if err := p.Validate(); err != nil {
 			return err
 		}
+
+		if strings.ToLower(p.Protocol) == "sctp" && (pr.RedirectPort != 0 || pr.Rules != nil) {
+			return fmt.Errorf("L7 rules cannot be applied to SCTP port %s", p.Port)
+		}
 	}
 
 	return nil
@@ -86,9 +90,9 @@ func (pp PortProtocol) Validate() error {
 	}
 
 	switch strings.ToLower(pp.Protocol) {
-	case "", "any", "tcp", "udp":
+	case "", "any", "tcp", "udp", "sctp":
 	default:
-		return fmt.Errorf("Invalid protocol %q, must be { tcp | udp }", pp.Protocol)
+		return fmt.Errorf("Invalid protocol %q, must be { tcp | udp | sctp }", pp.Protocol)
 	}
 
 	return nil
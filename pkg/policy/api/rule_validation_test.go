@@ -0,0 +1,60 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package api
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type PolicyAPITestSuite struct{}
+
+var _ = Suite(&PolicyAPITestSuite{})
+
+func (s *PolicyAPITestSuite) TestPortProtocolValidate(c *C) {
+	c.Assert(PortProtocol{Port: "80"}.Validate(), IsNil)
+	c.Assert(PortProtocol{Port: "80", Protocol: "TCP"}.Validate(), IsNil)
+	c.Assert(PortProtocol{Port: "2905", Protocol: "sctp"}.Validate(), IsNil)
+	c.Assert(PortProtocol{Port: "80", Protocol: "icmp"}.Validate(), Not(IsNil))
+	c.Assert(PortProtocol{Port: "0", Protocol: "tcp"}.Validate(), Not(IsNil))
+	c.Assert(PortProtocol{Protocol: "tcp"}.Validate(), Not(IsNil))
+}
+
+func (s *PolicyAPITestSuite) TestPortRuleValidateSCTP(c *C) {
+	sctp := []PortProtocol{{Port: "2905", Protocol: "sctp"}}
+
+	c.Assert(PortRule{Ports: sctp}.Validate(), IsNil)
+	c.Assert(PortRule{Ports: sctp, RedirectPort: 8080}.Validate(), Not(IsNil))
+	c.Assert(PortRule{Ports: sctp, Rules: &L7Rules{
+		HTTP: []PortRuleHTTP{{Method: "GET"}},
+	}}.Validate(), Not(IsNil))
+
+	// L7 rules remain allowed on TCP ports
+	tcp := []PortProtocol{{Port: "80", Protocol: "tcp"}}
+	c.Assert(PortRule{Ports: tcp, RedirectPort: 8080}.Validate(), IsNil)
+
+	rule := Rule{
+		EndpointSelector: NewESFromLabels(),
+		Ingress: []IngressRule{{
+			ToPorts: []PortRule{{Ports: sctp, RedirectPort: 8080}},
+		}},
+	}
+	c.Assert(rule.Validate(), Not(IsNil))
+}
@@ -164,7 +164,9 @@ func (l4 L4PolicyMap) containsAllL4(l4Ports []*models.Port) api.Decision {
 			_, tcpmatch := l4[tcpPort]
 			udpPort := fmt.Sprintf("%d/udp", l4CtxIng.Port)
 			_, udpmatch := l4[udpPort]
-			if !tcpmatch && !udpmatch {
+			sctpPort := fmt.Sprintf("%d/sctp", l4CtxIng.Port)
+			_, sctpmatch := l4[sctpPort]
+			if !tcpmatch && !udpmatch && !sctpmatch {
 				return api.Denied
 			}
 		default:
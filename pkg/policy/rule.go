@@ -73,6 +73,10 @@ func mergeL4(ctx *SearchContext, dir string, portRules []api.PortRule, resMap L4
 			} else {
 				found += mergeL4Port(ctx, r, p, "tcp", resMap)
 				found += mergeL4Port(ctx, r, p, "udp", resMap)
+				// L7 rules cannot be applied to SCTP
+				if r.RedirectPort == 0 && r.Rules == nil {
+					found += mergeL4Port(ctx, r, p, "sctp", resMap)
+				}
 			}
 		}
 	}
@@ -138,6 +138,7 @@ func (ds *PolicyTestSuite) TestL4Policy(c *C) {
 	expected.Ingress["8080/tcp"] = L4Filter{Port: 8080, Protocol: "tcp", L7Parser: "http", L7Rules: l7rules}
 	expected.Egress["3000/tcp"] = L4Filter{Port: 3000, Protocol: "tcp"}
 	expected.Egress["3000/udp"] = L4Filter{Port: 3000, Protocol: "udp"}
+	expected.Egress["3000/sctp"] = L4Filter{Port: 3000, Protocol: "sctp"}
 
 	state := traceState{}
 	res := rule1.resolveL4Policy(toBar, &state, NewL4Policy())
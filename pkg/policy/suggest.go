@@ -0,0 +1,186 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/policy/api"
+	"github.com/cilium/cilium/pkg/u8proto"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// Flow is a connection between two security identities which has been
+// observed to be allowed by the datapath
+type Flow struct {
+	Src     NumericIdentity
+	Dst     NumericIdentity
+	DstPort uint16
+	Proto   u8proto.U8proto
+}
+
+// FlowSet aggregates observed flows and counts the number of connections
+// seen for each of them
+type FlowSet map[Flow]int
+
+// NewFlowSet returns an empty set of flows
+func NewFlowSet() FlowSet {
+	return FlowSet{}
+}
+
+// Add records a new connection of flow f
+func (s FlowSet) Add(f Flow) {
+	s[f]++
+}
+
+// IdentityLabelsFunc returns the labels of a security identity
+type IdentityLabelsFunc func(id NumericIdentity) (labels.Labels, error)
+
+// portProtocol returns the port rule matching the destination of f or false
+// if the L4 protocol of f cannot be expressed in a port rule.
+func (f Flow) portProtocol() (api.PortProtocol, bool) {
+	switch f.Proto {
+	case u8proto.U8proto(6), u8proto.U8proto(17), u8proto.U8proto(132):
+		return api.PortProtocol{
+			Port:     strconv.Itoa(int(f.DstPort)),
+			Protocol: strings.ToLower(f.Proto.String()),
+		}, true
+	}
+	return api.PortProtocol{}, false
+}
+
+type identitySlice []NumericIdentity
+
+func (s identitySlice) Len() int           { return len(s) }
+func (s identitySlice) Less(i, j int) bool { return s[i] < s[j] }
+func (s identitySlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type portSlice []api.PortProtocol
+
+func (s portSlice) Len() int { return len(s) }
+func (s portSlice) Less(i, j int) bool {
+	pi, _ := strconv.Atoi(s[i].Port)
+	pj, _ := strconv.Atoi(s[j].Port)
+	if pi != pj {
+		return pi < pj
+	}
+	return s[i].Protocol < s[j].Protocol
+}
+func (s portSlice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+// SuggestRules returns a candidate set of rules allowing the flows in s and
+// nothing else. One rule is returned for each destination identity, selecting
+// the endpoints by all labels of the identity, with one ingress rule per
+// source identity allowing only the ports observed from that source. The
+// labels of each identity are resolved with lookup.
+//
+// Flows without a port, such as ICMP, cannot be allowed without allowing
+// all ports and are skipped.
+func (s FlowSet) SuggestRules(lookup IdentityLabelsFunc) (api.Rules, error) {
+	ports := map[NumericIdentity]map[NumericIdentity]map[api.PortProtocol]bool{}
+
+	for f := range s {
+		p, ok := f.portProtocol()
+		if !ok {
+			log.Warningf("Skipping %s flow from identity %d to %d, only TCP, UDP and SCTP are supported",
+				f.Proto.String(), f.Src, f.Dst)
+			continue
+		}
+
+		if _, ok := ports[f.Dst]; !ok {
+			ports[f.Dst] = map[NumericIdentity]map[api.PortProtocol]bool{}
+		}
+		if _, ok := ports[f.Dst][f.Src]; !ok {
+			ports[f.Dst][f.Src] = map[api.PortProtocol]bool{}
+		}
+		ports[f.Dst][f.Src][p] = true
+	}
+
+	selectors := map[NumericIdentity]api.EndpointSelector{}
+	selector := func(id NumericIdentity) (api.EndpointSelector, error) {
+		if es, ok := selectors[id]; ok {
+			return es, nil
+		}
+
+		lbls, err := lookup(id)
+		if err != nil {
+			return api.EndpointSelector{}, fmt.Errorf("unable to resolve identity %d: %s", id, err)
+		}
+		if len(lbls) == 0 {
+			return api.EndpointSelector{}, fmt.Errorf("identity %d has no labels", id)
+		}
+
+		lblList := make([]*labels.Label, 0, len(lbls))
+		for _, l := range lbls {
+			lblList = append(lblList, l)
+		}
+		es := api.NewESFromLabels(lblList...)
+		selectors[id] = es
+
+		return es, nil
+	}
+
+	dsts := make(identitySlice, 0, len(ports))
+	for dst := range ports {
+		dsts = append(dsts, dst)
+	}
+	sort.Sort(dsts)
+
+	rules := api.Rules{}
+	for _, dst := range dsts {
+		dstSelector, err := selector(dst)
+		if err != nil {
+			return nil, err
+		}
+
+		srcIDs := make(identitySlice, 0, len(ports[dst]))
+		for src := range ports[dst] {
+			srcIDs = append(srcIDs, src)
+		}
+		sort.Sort(srcIDs)
+
+		ingress := make([]api.IngressRule, 0, len(srcIDs))
+		for _, src := range srcIDs {
+			srcSelector, err := selector(src)
+			if err != nil {
+				return nil, err
+			}
+
+			srcPorts := make(portSlice, 0, len(ports[dst][src]))
+			for p := range ports[dst][src] {
+				srcPorts = append(srcPorts, p)
+			}
+			sort.Sort(srcPorts)
+
+			ingress = append(ingress, api.IngressRule{
+				FromEndpoints: []api.EndpointSelector{srcSelector},
+				ToPorts:       []api.PortRule{{Ports: srcPorts}},
+			})
+		}
+
+		rules = append(rules, &api.Rule{
+			EndpointSelector: dstSelector,
+			Ingress:          ingress,
+			Description:      "Suggested from observed flows",
+		})
+	}
+
+	return rules, nil
+}
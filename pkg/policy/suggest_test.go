@@ -0,0 +1,111 @@
+// Copyright 2017 Authors of Cilium
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"fmt"
+
+	"github.com/cilium/cilium/common"
+	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/policy/api"
+	"github.com/cilium/cilium/pkg/u8proto"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *PolicyTestSuite) TestSuggestRules(c *C) {
+	lbls := map[NumericIdentity]labels.Labels{
+		ID_WORLD: labels.Labels{
+			"world": labels.NewLabel("world", "", common.ReservedLabelSource),
+		},
+		256: labels.Labels{
+			"app": labels.NewLabel("app", "frontend", common.CiliumLabelSource),
+		},
+		257: labels.Labels{
+			"app": labels.NewLabel("app", "backend", common.CiliumLabelSource),
+		},
+		258: labels.Labels{
+			"app": labels.NewLabel("app", "db", common.CiliumLabelSource),
+		},
+	}
+	lookup := func(id NumericIdentity) (labels.Labels, error) {
+		if l, ok := lbls[id]; ok {
+			return l, nil
+		}
+		return nil, fmt.Errorf("unknown identity")
+	}
+	selector := func(id NumericIdentity) api.EndpointSelector {
+		return api.NewESFromLabels(lbls[id]["app"])
+	}
+
+	flows := NewFlowSet()
+	flows.Add(Flow{Src: 257, Dst: 258, DstPort: 5432, Proto: u8proto.U8proto(6)})
+	flows.Add(Flow{Src: 256, Dst: 257, DstPort: 8080, Proto: u8proto.U8proto(6)})
+	flows.Add(Flow{Src: 256, Dst: 257, DstPort: 8080, Proto: u8proto.U8proto(6)})
+	flows.Add(Flow{Src: 256, Dst: 257, DstPort: 53, Proto: u8proto.U8proto(17)})
+	flows.Add(Flow{Src: 258, Dst: 257, DstPort: 9090, Proto: u8proto.U8proto(6)})
+	flows.Add(Flow{Src: 258, Dst: 257, Proto: u8proto.U8proto(58)})
+	c.Assert(len(flows), Equals, 5)
+	c.Assert(flows[Flow{Src: 256, Dst: 257, DstPort: 8080, Proto: u8proto.U8proto(6)}], Equals, 2)
+
+	// Every source is only allowed the ports it has been observed on and
+	// the ICMPv6 flow does not widen the rule of its source
+	rules, err := flows.SuggestRules(lookup)
+	c.Assert(err, IsNil)
+	c.Assert(rules, DeepEquals, api.Rules{
+		{
+			EndpointSelector: selector(257),
+			Ingress: []api.IngressRule{
+				{
+					FromEndpoints: []api.EndpointSelector{selector(256)},
+					ToPorts: []api.PortRule{{
+						Ports: []api.PortProtocol{
+							{Port: "53", Protocol: "udp"},
+							{Port: "8080", Protocol: "tcp"},
+						},
+					}},
+				},
+				{
+					FromEndpoints: []api.EndpointSelector{selector(258)},
+					ToPorts: []api.PortRule{{
+						Ports: []api.PortProtocol{{Port: "9090", Protocol: "tcp"}},
+					}},
+				},
+			},
+			Description: "Suggested from observed flows",
+		},
+		{
+			EndpointSelector: selector(258),
+			Ingress: []api.IngressRule{{
+				FromEndpoints: []api.EndpointSelector{selector(257)},
+				ToPorts: []api.PortRule{{
+					Ports: []api.PortProtocol{{Port: "5432", Protocol: "tcp"}},
+				}},
+			}},
+			Description: "Suggested from observed flows",
+		},
+	})
+
+	// Destinations with port-less flows only are skipped
+	flows = NewFlowSet()
+	flows.Add(Flow{Src: ID_WORLD, Dst: 256, Proto: u8proto.U8proto(1)})
+	rules, err = flows.SuggestRules(lookup)
+	c.Assert(err, IsNil)
+	c.Assert(len(rules), Equals, 0)
+
+	flows.Add(Flow{Src: 300, Dst: 256, DstPort: 80, Proto: u8proto.U8proto(6)})
+	_, err = flows.SuggestRules(lookup)
+	c.Assert(err, Not(IsNil))
+}
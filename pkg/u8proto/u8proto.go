@@ -21,10 +21,11 @@ import (
 )
 
 var protoNames = map[int]string{
-	1:  "ICMP",
-	6:  "TCP",
-	17: "UDP",
-	58: "ICMPv6",
+	1:   "ICMP",
+	6:   "TCP",
+	17:  "UDP",
+	58:  "ICMPv6",
+	132: "SCTP",
 }
 
 var protoIDs = map[string]U8proto{
@@ -32,6 +33,7 @@ var protoIDs = map[string]U8proto{
 	"tcp":    6,
 	"udp":    17,
 	"icmpv6": 58,
+	"sctp":   132,
 }
 
 type U8proto uint8
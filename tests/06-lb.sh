@@ -73,13 +73,13 @@ cilium service update --frontend [::]:80 --backends '[::1]:90,[::2]:91' --id 1 -
 
 # Check if it's the only service present
 if [[ "$(cilium service list)" != \
-      "$(echo -e "[::]:80 =>\n\t\t1 => [::1]:90 (1)\n\t\t2 => [::2]:91 (1)")" ]]; then
+      "$(echo -e "[::]:80/TCP =>\n\t\t1 => [::1]:90 (1)\n\t\t2 => [::2]:91 (1)")" ]]; then
      abort "Service was not properly added"
 fi
 
 # Check if we can get the service by it's ID
 if [[ "$(cilium service get 1)" != \
-      "$(echo -e "[::]:80 =>\n\t\t1 => [::1]:90 (1)\n\t\t2 => [::2]:91 (1)")" ]]; then
+      "$(echo -e "[::]:80/TCP =>\n\t\t1 => [::1]:90 (1)\n\t\t2 => [::2]:91 (1)")" ]]; then
      abort "Service was not properly added"
 fi
 
@@ -90,7 +90,7 @@ cilium service update --frontend [::]:80 --backends [::1]:90,[::2]:91 --id 0 --r
 
 # BPF's map should be unmodified
 if [[ "$(cilium service list)" != \
-      "$(echo -e "[::]:80 =>\n\t\t1 => [::1]:90 (1)\n\t\t2 => [::2]:91 (1)")" ]]; then
+      "$(echo -e "[::]:80/TCP =>\n\t\t1 => [::1]:90 (1)\n\t\t2 => [::2]:91 (1)")" ]]; then
      abort "Service with ID 0 should not have been added"
 fi
 
@@ -101,10 +101,30 @@ cilium service update --frontend [::]:80 --backends [::1]:90,[::2]:91 --id 2 --r
 
 # Check if it's the only service present
 if [[ "$(cilium service list)" != \
-      "$(echo -e "[::]:80 =>\n\t\t1 => [::1]:90 (1)\n\t\t2 => [::2]:91 (1)")" ]]; then
+      "$(echo -e "[::]:80/TCP =>\n\t\t1 => [::1]:90 (1)\n\t\t2 => [::2]:91 (1)")" ]]; then
      abort "Service ID 2 seems to have been added after all"
 fi
 
+# Add a UDP service with the same frontend address, it should be distinct
+cilium service update --frontend [::]:80 --protocol udp --backends [::1]:90 --id 2 --rev 2> /dev/null || {
+	abort "UDP service should have been added"
+}
+
+if [[ "$(cilium service list)" != \
+      "$(echo -e "[::]:80/TCP =>\n\t\t1 => [::1]:90 (1)\n\t\t2 => [::2]:91 (1)\n[::]:80/UDP =>\n\t\t1 => [::1]:90 (2)")" ]]; then
+     abort "UDP service was not properly added"
+fi
+
+# A service matching all protocols on the same frontend address is ambiguous
+cilium service update --frontend [::]:80 --protocol any --backends [::1]:90 --id 3 --rev 2> /dev/null && {
+	abort "Ambiguous service should not have been added"
+}
+
+if [[ "$(cilium service delete 2)" != \
+      "$(echo -e "Service 2 deleted successfully")" ]]; then
+     abort "Service ID 2 could not be deleted"
+fi
+
 # Let's try delete the only service
 if [[ "$(cilium service delete 1)" != \
       "$(echo -e "Service 1 deleted successfully")" ]]; then
@@ -150,13 +170,13 @@ if [ -n "${IPV4}" ]; then
 
 	# Check if it's the only service present
 	if [[ "$(cilium service list)" != \
-	      "$(echo -e "127.0.0.1:80 =>\n\t\t1 => 127.0.0.2:90 (10)\n\t\t2 => 127.0.0.3:90 (10)")" ]]; then
+	      "$(echo -e "127.0.0.1:80/TCP =>\n\t\t1 => 127.0.0.2:90 (10)\n\t\t2 => 127.0.0.3:90 (10)")" ]]; then
 	     abort "Service was not properly added"
 	fi
 
 	# Check if we can get the service by it's ID
 	if [[ "$(cilium service get 10)" != \
-	      "$(echo -e "127.0.0.1:80 =>\n\t\t1 => 127.0.0.2:90 (10)\n\t\t2 => 127.0.0.3:90 (10)")" ]]; then
+	      "$(echo -e "127.0.0.1:80/TCP =>\n\t\t1 => 127.0.0.2:90 (10)\n\t\t2 => 127.0.0.3:90 (10)")" ]]; then
 	     abort "Service was not properly added"
 	fi
 
@@ -167,19 +187,19 @@ if [ -n "${IPV4}" ]; then
 
 	# Check if it's the only service present
 	if [[ "$(cilium service list)" != \
-	      "$(echo -e "127.0.0.1:80 =>\n\t\t1 => 127.0.0.2:90 (10)\n\t\t2 => 127.0.0.3:90 (10)")" ]]; then
+	      "$(echo -e "127.0.0.1:80/TCP =>\n\t\t1 => 127.0.0.2:90 (10)\n\t\t2 => 127.0.0.3:90 (10)")" ]]; then
 	     abort "Service ID 20 seems to have been added after all"
 	fi
 
 #	# Check if we can get the service by it's ID
 #	if [[ "$(cilium service get 20)" != \
-#	      "$(echo -e "127.0.0.1:80 =>\n\t\t1 => 127.0.0.2:90 (20)\n\t\t2 => 127.0.0.3:90 (20)")" ]]; then
+#	      "$(echo -e "127.0.0.1:80/TCP =>\n\t\t1 => 127.0.0.2:90 (20)\n\t\t2 => 127.0.0.3:90 (20)")" ]]; then
 #	     abort "Service was not properly added"
 #	fi
 
 #	# BPF's map should contain service with a different RevNAT ID
 #	if [[ "$(cilium service list)" != \
-#	      "$(echo -e "127.0.0.1:80 =>\n\t\t1 => 127.0.0.2:90 (20)\n\t\t2 => 127.0.0.3:90 (20)\n")" ]]; then
+#	      "$(echo -e "127.0.0.1:80/TCP =>\n\t\t1 => 127.0.0.2:90 (20)\n\t\t2 => 127.0.0.3:90 (20)\n")" ]]; then
 #	     abort "Service was not properly added"
 #	fi
 
@@ -0,0 +1,81 @@
+#!/bin/bash
+
+source "./helpers.bash"
+
+TEST_NET="cilium"
+SERVER_LABEL="id.server"
+CLIENT_LABEL="id.client"
+SUGGEST_FILE=$(mktemp)
+
+function cleanup {
+	cilium policy delete --all 2> /dev/null || true
+	docker rm -f server client 2> /dev/null || true
+	rm -f $SUGGEST_FILE
+}
+
+trap cleanup EXIT
+
+cleanup
+logs_clear
+
+docker network inspect $TEST_NET 2> /dev/null || {
+	docker network create --ipv6 --subnet ::1/112 --ipam-driver cilium --driver cilium $TEST_NET
+}
+
+docker run -dt --net=$TEST_NET --name server -l $SERVER_LABEL cilium/demo-httpd
+
+SERVER_IP4=$(docker inspect --format '{{ .NetworkSettings.Networks.cilium.IPAddress }}' server)
+SERVER_ID=$(cilium endpoint list | grep $SERVER_LABEL | awk '{ print $1}')
+
+echo -n "Sleeping 3 seconds..."
+sleep 3
+echo " done."
+set -x
+
+cilium endpoint config $SERVER_ID FlowNotification=true
+
+cilium policy delete --all
+cat <<EOF | cilium -D policy import -
+[{
+    "endpointSelector": {"matchLabels":{"id.server":""}},
+    "ingress": [{
+        "fromEndpoints": [
+	    {"matchLabels":{"id.client":""}}
+	],
+	"toPorts": [{
+	    "ports": [{"port": "80", "protocol": "tcp"}]
+	}]
+    }]
+}]
+EOF
+
+sleep 2
+
+cilium policy suggest --from-flows 30s > $SUGGEST_FILE &
+SUGGEST_PID=$!
+sleep 2
+
+echo "------ connection allowed by policy ------"
+RETURN=$(docker run --rm=true -i --net=$TEST_NET --name client -l $CLIENT_LABEL tgraf/netperf bash -c "curl -s --output /dev/null -w '%{http_code}' --connect-timeout 10 http://$SERVER_IP4:80/public")
+if [[ "${RETURN//$'\n'}" != "200" ]]; then
+	abort "GET :80, unexpected return"
+fi
+
+echo "------ connection denied by L4 policy ------"
+docker run --rm=true -i --net=$TEST_NET --name client -l $CLIENT_LABEL tgraf/netperf bash -c "curl -s --output /dev/null --connect-timeout 5 http://$SERVER_IP4:8080/" && {
+	abort "GET :8080 succeeded despite L4 policy"
+}
+
+wait $SUGGEST_PID || abort "cilium policy suggest failed"
+cat $SUGGEST_FILE
+
+if ! grep -q '"port": "80"' $SUGGEST_FILE; then
+	abort "Allowed port 80 missing from suggested policy"
+fi
+
+# Connections dropped by L4 policy must not be reported as allowed
+if grep -q '"port": "8080"' $SUGGEST_FILE; then
+	abort "Port 8080 denied by L4 policy included in suggested policy"
+fi
+
+cilium policy delete --all